@@ -19,6 +19,7 @@ import (
 	"flag"
 	"os"
 	"path/filepath"
+	"time"
 
 	_ "k8s.io/client-go/plugin/pkg/client/auth"
 	"k8s.io/klog/v2"
@@ -35,10 +36,15 @@ import (
 	metricsserver "sigs.k8s.io/controller-runtime/pkg/metrics/server"
 	"sigs.k8s.io/controller-runtime/pkg/webhook"
 
+	"github.com/vlasov-y/moneypod/internal/cluster"
 	. "github.com/vlasov-y/moneypod/internal/controllers/node"
 	. "github.com/vlasov-y/moneypod/internal/controllers/pod"
+	. "github.com/vlasov-y/moneypod/internal/controllers/pv"
+	"github.com/vlasov-y/moneypod/internal/cur"
 	"github.com/vlasov-y/moneypod/internal/monitoring"
+	"github.com/vlasov-y/moneypod/internal/providers"
 	"github.com/vlasov-y/moneypod/internal/types"
+	"github.com/vlasov-y/moneypod/internal/usage"
 	metricsv1beta1 "k8s.io/metrics/pkg/apis/metrics/v1beta1"
 	// +kubebuilder:scaffold:imports
 )
@@ -67,6 +73,16 @@ func main() {
 	var qps float64
 	var burst int
 	var maxConcurrentReconciles int
+	var providersOpts providers.Options
+	var curOpts cur.Options
+	var clusterOpts cluster.Options
+	var usageOpts usage.Options
+	// Accelerators take the most of the node price, CPU and memory get the rest
+	podOpts := PodOptions{ExtendedResourceShares: types.ResourceShares{
+		"nvidia.com/gpu":        0.8,
+		"amd.com/gpu":           0.8,
+		"aws.amazon.com/neuron": 0.8,
+	}}
 	var tlsOpts []func(*tls.Config)
 	flag.StringVar(&metricsAddr, "metrics-bind-address", "0", "The address the metrics endpoint binds to. "+
 		"Use :8443 for HTTPS or :8080 for HTTP, or leave as 0 to disable the metrics service.")
@@ -87,13 +103,88 @@ func main() {
 		"The directory that contains the metrics server certificate.")
 	flag.StringVar(&metricsCertName, "metrics-cert-name", "tls.crt", "The name of the metrics server certificate file.")
 	flag.StringVar(&metricsCertKey, "metrics-cert-key", "tls.key", "The name of the metrics server key file.")
+	flag.Var(podOpts.ExtendedResourceShares, "extended-resource-shares",
+		"Comma-separated shares of the node cost taken by all units of the extended resource, e.g. nvidia.com/gpu=0.8")
 	flag.BoolVar(&enableHTTP2, "enable-http2", false,
 		"If set, HTTP/2 will be enabled for the metrics and webhook servers")
+	flag.StringVar(&providersOpts.AWS.EC2Endpoint, "aws-ec2-endpoint", "",
+		"Custom EC2 API endpoint, e.g. a VPC endpoint or LocalStack")
+	flag.StringVar(&providersOpts.AWS.ExternalID, "aws-external-id", "",
+		"External ID passed when assuming --aws-role-arn")
+	flag.IntVar(&providersOpts.AWS.MaxAttempts, "aws-max-attempts", 0,
+		"Maximum number of attempts per AWS API call, 0 for the SDK default")
+	flag.DurationVar(&providersOpts.AWS.MaxBackoff, "aws-max-backoff", 0,
+		"Maximum delay between AWS API call retries, 0 for the SDK default")
+	flag.BoolVar(&providersOpts.AWS.NoCredentials, "aws-no-credentials", false,
+		"If set, AWS nodes are priced from their labels with the price list or rates, no AWS API is called")
+	flag.StringVar(&providersOpts.AWS.PriceListPath, "aws-price-list-path", "",
+		"Path to the EC2 bulk price list offer file (JSON or CSV, optionally gzipped) or a directory with them. "+
+			"If set, prices are looked up there instead of querying the Pricing API.")
+	flag.StringVar(&providersOpts.AWS.PriceListReference, "aws-price-list-reference", "",
+		"OCI artifact reference with the EC2 bulk price list offer files, e.g. registry.local/pricing/ec2:latest. "+
+			"If set, it is pulled on startup and used instead of the Pricing API.")
+	flag.BoolVar(&providersOpts.AWS.PriceListPlainHTTP, "aws-price-list-plain-http", false,
+		"If set, the price list OCI artifact is pulled over plain HTTP")
+	flag.StringVar(&providersOpts.AWS.PricingEndpoint, "aws-pricing-endpoint", "",
+		"Custom Pricing API endpoint, e.g. a VPC endpoint or LocalStack")
+	flag.StringVar(&providersOpts.AWS.RatesPath, "aws-rates-path", "",
+		"Path to the YAML file with hourly rates used when no price is found in the Pricing API or the price list, "+
+			"e.g. for GovCloud regions.")
+	flag.StringVar(&providersOpts.AWS.RoleARN, "aws-role-arn", "",
+		"AWS role to assume for all AWS API calls, e.g. in another account")
+	flag.StringVar(&providersOpts.AWS.STSEndpoint, "aws-sts-endpoint", "",
+		"Custom STS endpoint used to assume --aws-role-arn")
+	flag.BoolVar(&clusterOpts.AllocateFixedCosts, "allocate-fixed-costs", false,
+		"If set, the cluster fixed costs are split between namespaces in proportion to their requests cost")
+	flag.Float64Var(&clusterOpts.EKSAutoModeFeeRatio, "eks-auto-mode-fee-ratio", 0,
+		"EKS Auto Mode management fee as a share of the node hourly cost, the fee of the instance type divided "+
+			"by its on-demand price, 0 to disable")
+	flag.Float64Var(&clusterOpts.EKSControlPlaneHourlyCost, "eks-control-plane-hourly-cost", 0.10,
+		"EKS control plane fee added to the cluster fixed costs if the cluster is EKS, 0 to disable")
+	flag.StringVar(&clusterOpts.FixedCostsPath, "fixed-costs-path", "",
+		"Path to the YAML file with the cluster fixed costs, e.g. NAT gateways or support plans")
+	flag.StringVar(&clusterOpts.SharedCostsPath, "shared-costs-path", "",
+		"Path to the YAML file with the rules of the platform pods whose cost is shared by the tenant namespaces")
+	flag.BoolVar(&podOpts.ContainerMetrics, "container-metrics", true,
+		"If set, the requests and usage cost is exported per container as well. "+
+			"Use --container-metrics=false on clusters with high cardinality.")
+	flag.Float64Var(&podOpts.CPUMemoryCostRatio, "cpu-memory-cost-ratio", 1,
+		"GiBs of memory 1 CPU core costs with the ratio split strategy")
+	flag.StringVar(&podOpts.CPUMemorySKUsPath, "cpu-memory-skus-path", "",
+		"Path to the YAML file with per-vCPU and per-GiB prices of instance families for the sku split strategy")
+	flag.StringVar(&podOpts.SplitStrategy, "cpu-memory-split", SplitEqualUnits,
+		"Strategy of the node cost split between CPU and memory: equal-units, ratio or sku")
+	flag.StringVar(&curOpts.Bucket, "cur-bucket", "",
+		"S3 bucket with the Cost and Usage Report 2.0 exports, the invoiced cost is not computed if empty")
+	flag.StringVar(&curOpts.Endpoint, "cur-endpoint", "",
+		"Custom S3 endpoint of the --cur-bucket, e.g. MinIO")
+	flag.DurationVar(&curOpts.Interval, "cur-interval", 24*time.Hour,
+		"How often the Cost and Usage Report is read")
+	flag.BoolVar(&curOpts.PathStyle, "cur-path-style", false,
+		"Use path-style addressing of the --cur-bucket, required by MinIO")
+	flag.StringVar(&curOpts.Prefix, "cur-prefix", "",
+		"Key prefix of the Cost and Usage Report export files")
+	flag.StringVar(&curOpts.Region, "cur-region", "",
+		"Region of the --cur-bucket, taken from the environment if empty")
+	flag.StringVar(&podOpts.PendingEstimate, "pending-estimate", PendingEstimateCheapest,
+		"Estimate of the unscheduled pod cost over the nodes it fits: cheapest or median")
+	flag.StringVar(&podOpts.PricingBasis, "pricing-basis", PricingBasisAllocatable,
+		"Node resources the node cost is divided by: allocatable spreads the reserved resources over the pods, "+
+			"capacity exports them as moneypod_node_reserved_hourly_cost")
+	flag.StringVar(&podOpts.RequestsPolicy, "requests-policy", RequestsPolicyNone,
+		"Policy for the containers without CPU or memory requests: none, limits, limitrange or minimum")
+	flag.StringVar(&podOpts.MinimumCPURequest, "minimum-cpu-request", "10m",
+		"CPU charged for the missing request with the minimum requests policy")
+	flag.StringVar(&podOpts.MinimumMemoryRequest, "minimum-memory-request", "32Mi",
+		"Memory charged for the missing request with the minimum requests policy")
+	flag.DurationVar(&usageOpts.Interval, "usage-interval", 30*time.Second,
+		"How often the pods usage is read from the metrics API to price it, 0 to disable")
 	opts := zap.Options{
 		Development: true,
 	}
 	opts.BindFlags(flag.CommandLine)
 	flag.Parse()
+	usageOpts.ContainerMetrics = podOpts.ContainerMetrics
 
 	ctrl.SetLogger(zap.New(zap.UseFlagOptions(&opts)))
 	klog.SetLogger(ctrl.Log)
@@ -182,6 +273,12 @@ func main() {
 		})
 	}
 
+	ctx := ctrl.SetupSignalHandler()
+	if err := providers.Setup(ctx, providersOpts); err != nil {
+		setupLog.Error(err, "unable to set up providers")
+		os.Exit(1)
+	}
+
 	rc := ctrl.GetConfigOrDie()
 	rc.QPS = float32(qps)
 	rc.Burst = burst
@@ -222,12 +319,62 @@ func main() {
 	}
 	if err := (&PodReconciler{
 		Reconciler: types.NewReconciler(mgr),
+		Options:    podOpts,
 	}).SetupWithManager(mgr); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "Pod")
 		os.Exit(1)
 	}
+	if err := (&PersistentVolumeReconciler{
+		Reconciler: types.NewReconciler(mgr),
+	}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "PersistentVolume")
+		os.Exit(1)
+	}
 	// +kubebuilder:scaffold:builder
 
+	for _, job := range providers.Jobs() {
+		if err := mgr.Add(job); err != nil {
+			setupLog.Error(err, "unable to add the provider job to manager")
+			os.Exit(1)
+		}
+	}
+
+	clusterJob, err := cluster.NewJob(ctx, mgr.GetClient(), mgr.GetConfig(), clusterOpts)
+	if err != nil {
+		setupLog.Error(err, "unable to set up the cluster costs job")
+		os.Exit(1)
+	}
+	if err := mgr.Add(clusterJob); err != nil {
+		setupLog.Error(err, "unable to add the cluster costs job to manager")
+		os.Exit(1)
+	}
+
+	if curOpts.Bucket != "" {
+		curOpts.AWS = providersOpts.AWS
+		job, err := cur.NewJob(ctx, mgr.GetClient(), curOpts)
+		if err != nil {
+			setupLog.Error(err, "unable to set up the Cost and Usage Report job")
+			os.Exit(1)
+		}
+		if err := mgr.Add(job); err != nil {
+			setupLog.Error(err, "unable to add the Cost and Usage Report job to manager")
+			os.Exit(1)
+		}
+	}
+
+	if usageOpts.Interval > 0 {
+		// Metrics API cannot be watched, so it is read without the cache
+		job, err := usage.NewJob(ctx, mgr.GetAPIReader(), usageOpts)
+		if err != nil {
+			setupLog.Error(err, "unable to set up the usage sampler")
+			os.Exit(1)
+		}
+		if err := mgr.Add(job); err != nil {
+			setupLog.Error(err, "unable to add the usage sampler to manager")
+			os.Exit(1)
+		}
+	}
+
 	if metricsCertWatcher != nil {
 		setupLog.Info("Adding metrics certificate watcher to manager")
 		if err := mgr.Add(metricsCertWatcher); err != nil {
@@ -254,7 +401,7 @@ func main() {
 	}
 
 	setupLog.Info("starting manager")
-	if err := mgr.Start(ctrl.SetupSignalHandler()); err != nil {
+	if err := mgr.Start(ctx); err != nil {
 		setupLog.Error(err, "problem running manager")
 		os.Exit(1)
 	}
@@ -0,0 +1,33 @@
+// Copyright 2025 The MoneyPod Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cluster
+
+// allocateFixedCosts splits the fixed cost between namespaces in proportion to their requests cost
+func allocateFixedCosts(hourlyCost float64, pods []PodCost) (namespaces map[string]float64) {
+	namespaces = map[string]float64{}
+	var total float64
+	for _, pod := range pods {
+		namespaces[pod.Namespace] += pod.RequestsHourlyCost
+		total += pod.RequestsHourlyCost
+	}
+	for namespace, requestsCost := range namespaces {
+		if total > 0 {
+			namespaces[namespace] = hourlyCost * requestsCost / total
+		} else {
+			namespaces[namespace] = 0
+		}
+	}
+	return
+}
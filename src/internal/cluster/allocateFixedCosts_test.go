@@ -0,0 +1,38 @@
+// Copyright 2025 The MoneyPod Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cluster
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("allocateFixedCosts", func() {
+	It("should split the cost in proportion to the requests cost", func() {
+		namespaces := allocateFixedCosts(1.0, []PodCost{
+			{Namespace: "a", RequestsHourlyCost: 0.3},
+			{Namespace: "a", RequestsHourlyCost: 0.1},
+			{Namespace: "b", RequestsHourlyCost: 0.6},
+		})
+		Expect(namespaces).To(HaveLen(2))
+		Expect(namespaces).To(HaveKeyWithValue("a", BeNumerically("~", 0.4, 1e-9)))
+		Expect(namespaces).To(HaveKeyWithValue("b", BeNumerically("~", 0.6, 1e-9)))
+	})
+
+	It("should allocate nothing without the requests", func() {
+		namespaces := allocateFixedCosts(1.0, []PodCost{{Namespace: "a"}})
+		Expect(namespaces).To(HaveKeyWithValue("a", BeZero()))
+	})
+})
@@ -0,0 +1,87 @@
+// Copyright 2025 The MoneyPod Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cluster
+
+import (
+	"slices"
+
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+// allocateSharedCosts moves the requests cost of the pods matching the rules from their namespaces
+// to the tenant ones, the namespaces with pods not matching any rule. The first matching rule is applied.
+// Own cost is the cost of the namespace pods, the cost with shared is the own one moved by the rules.
+func allocateSharedCosts(rules []sharedCostRule, pods []PodCost, namespaces map[string]labels.Set) (
+	own map[string]float64, withShared map[string]float64, shared map[string]float64) {
+	own = map[string]float64{}
+	withShared = map[string]float64{}
+	shared = map[string]float64{}
+
+	// Weights of the tenant namespaces per split
+	weights := map[string]map[string]float64{sharedSplitEven: {}, sharedSplitRequests: {}, sharedSplitUsage: {}}
+	// Shared cost per rule and the namespace it is taken from
+	taken := map[string]map[string]float64{}
+	for _, pod := range pods {
+		own[pod.Namespace] += pod.RequestsHourlyCost
+		withShared[pod.Namespace] += pod.RequestsHourlyCost
+
+		i := slices.IndexFunc(rules, func(rule sharedCostRule) bool { return rule.matches(pod, namespaces[pod.Namespace]) })
+		if i < 0 {
+			weights[sharedSplitEven][pod.Namespace] = 1
+			weights[sharedSplitRequests][pod.Namespace] += pod.RequestsHourlyCost
+			weights[sharedSplitUsage][pod.Namespace] += pod.UsageHourlyCost
+			continue
+		}
+		if taken[rules[i].Name] == nil {
+			taken[rules[i].Name] = map[string]float64{}
+		}
+		taken[rules[i].Name][pod.Namespace] += pod.RequestsHourlyCost
+	}
+
+	for _, rule := range rules {
+		var total float64
+		for _, hourlyCost := range taken[rule.Name] {
+			total += hourlyCost
+		}
+		// Cost stays in place if there is nobody to share it with
+		split := weights[rule.Split]
+		if sum(split) == 0 {
+			split = weights[sharedSplitRequests]
+		}
+		if sum(split) == 0 {
+			split = weights[sharedSplitEven]
+		}
+		if total == 0 || len(split) == 0 {
+			continue
+		}
+		shared[rule.Name] += total
+		for namespace, hourlyCost := range taken[rule.Name] {
+			withShared[namespace] -= hourlyCost
+		}
+		splitSum := sum(split)
+		for namespace, weight := range split {
+			withShared[namespace] += total * weight / splitSum
+		}
+	}
+	return
+}
+
+// sum adds up the values of the map
+func sum(values map[string]float64) (total float64) {
+	for _, value := range values {
+		total += value
+	}
+	return
+}
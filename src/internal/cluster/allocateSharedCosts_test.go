@@ -0,0 +1,63 @@
+// Copyright 2025 The MoneyPod Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cluster
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+var _ = Describe("allocateSharedCosts", func() {
+	pods := []PodCost{
+		{Namespace: "kube-system", RequestsHourlyCost: 0.4},
+		{Namespace: "a", RequestsHourlyCost: 0.3, UsageHourlyCost: 0.1},
+		{Namespace: "a", OwnerKind: "DaemonSet", RequestsHourlyCost: 0.2},
+		{Namespace: "b", RequestsHourlyCost: 0.1, UsageHourlyCost: 0.3},
+	}
+	namespaces := map[string]labels.Set{}
+
+	It("should split the shared cost in proportion to the tenants requests cost", func() {
+		rules := []sharedCostRule{
+			{Name: "kube-system", Namespaces: []string{"kube-system"}, Split: sharedSplitRequests},
+			{Name: "daemonsets", OwnerKinds: []string{"DaemonSet"}, Split: sharedSplitEven},
+		}
+		own, withShared, shared := allocateSharedCosts(rules, pods, namespaces)
+		Expect(own).To(HaveKeyWithValue("kube-system", BeNumerically("~", 0.4, 1e-9)))
+		Expect(own).To(HaveKeyWithValue("a", BeNumerically("~", 0.5, 1e-9)))
+		Expect(own).To(HaveKeyWithValue("b", BeNumerically("~", 0.1, 1e-9)))
+		Expect(shared).To(HaveKeyWithValue("kube-system", BeNumerically("~", 0.4, 1e-9)))
+		Expect(shared).To(HaveKeyWithValue("daemonsets", BeNumerically("~", 0.2, 1e-9)))
+		Expect(withShared).To(HaveKeyWithValue("kube-system", BeNumerically("~", 0, 1e-9)))
+		// 0.3 own + 0.4 * 3/4 + 0.2 / 2
+		Expect(withShared).To(HaveKeyWithValue("a", BeNumerically("~", 0.7, 1e-9)))
+		// 0.1 own + 0.4 * 1/4 + 0.2 / 2
+		Expect(withShared).To(HaveKeyWithValue("b", BeNumerically("~", 0.3, 1e-9)))
+	})
+
+	It("should split the shared cost in proportion to the tenants usage cost", func() {
+		rules := []sharedCostRule{{Name: "kube-system", Namespaces: []string{"kube-system"}, Split: sharedSplitUsage}}
+		_, withShared, _ := allocateSharedCosts(rules, pods, namespaces)
+		Expect(withShared).To(HaveKeyWithValue("a", BeNumerically("~", 0.6, 1e-9)))
+		Expect(withShared).To(HaveKeyWithValue("b", BeNumerically("~", 0.4, 1e-9)))
+	})
+
+	It("should keep the cost in place without tenants", func() {
+		rules := []sharedCostRule{{Name: "all", Namespaces: []string{"kube-system"}, Split: sharedSplitRequests}}
+		own, withShared, shared := allocateSharedCosts(rules, pods[:1], namespaces)
+		Expect(withShared).To(Equal(own))
+		Expect(shared).To(BeEmpty())
+	})
+})
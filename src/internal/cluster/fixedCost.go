@@ -0,0 +1,61 @@
+// Copyright 2025 The MoneyPod Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cluster
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/yaml"
+)
+
+// Names of the detected fixed costs
+const (
+	fixedCostEKSControlPlane   = "eks-control-plane"
+	fixedCostEKSAutoMode       = "eks-auto-mode"
+	fixedCostControlPlaneNodes = "control-plane-nodes"
+)
+
+// fixedCost is a cost of the cluster not attributed to any pod, e.g. a NAT gateway or a support plan.
+type fixedCost struct {
+	Name       string  `json:"name"`
+	HourlyCost float64 `json:"hourlyCost"`
+}
+
+// loadFixedCosts reads the list of fixed costs from the YAML or JSON file
+func loadFixedCosts(ctx context.Context, path string) (costs []fixedCost, err error) {
+	log := logf.FromContext(ctx)
+
+	var data []byte
+	if data, err = os.ReadFile(path); err != nil {
+		log.Error(err, "failed to read the fixed costs file", "path", path)
+		return
+	}
+	if err = yaml.UnmarshalStrict(data, &costs); err != nil {
+		log.Error(err, "failed to parse the fixed costs file", "path", path)
+		return
+	}
+	for i, c := range costs {
+		if c.Name == "" || c.HourlyCost <= 0 {
+			err = fmt.Errorf("fixed cost #%d has no name or positive hourlyCost", i)
+			log.Error(err, "invalid fixed costs file", "path", path)
+			return
+		}
+	}
+	log.Info("loaded fixed costs", "path", path, "count", len(costs))
+	return
+}
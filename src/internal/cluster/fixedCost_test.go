@@ -0,0 +1,57 @@
+// Copyright 2025 The MoneyPod Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cluster
+
+import (
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("loadFixedCosts", func() {
+	writeFixedCosts := func(content string) string {
+		path := filepath.Join(GinkgoT().TempDir(), "fixed-costs.yaml")
+		Expect(os.WriteFile(path, []byte(content), 0o600)).To(Succeed())
+		return path
+	}
+
+	It("should load the valid file", func() {
+		costs, err := loadFixedCosts(ctx, writeFixedCosts(`
+- name: nat-gateway
+  hourlyCost: 0.045
+- name: support
+  hourlyCost: 1.37
+`))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(costs).To(Equal([]fixedCost{{"nat-gateway", 0.045}, {"support", 1.37}}))
+	})
+
+	It("should fail on the broken file", func() {
+		for _, content := range []string{
+			`- hourlyCost: 0.045`,
+			`- name: nat-gateway`,
+			`- name: nat-gateway
+  hourlyCost: 0.045
+  unknownField: value`,
+			`not a list`,
+		} {
+			By(content)
+			_, err := loadFixedCosts(ctx, writeFixedCosts(content))
+			Expect(err).To(HaveOccurred())
+		}
+	})
+})
@@ -0,0 +1,53 @@
+// Copyright 2025 The MoneyPod Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cluster
+
+import (
+	"strconv"
+
+	. "github.com/vlasov-y/moneypod/internal/types"
+	corev1 "k8s.io/api/core/v1"
+)
+
+// Node labels of the fixed cost sources
+const (
+	labelControlPlane   = "node-role.kubernetes.io/control-plane"
+	labelEKSComputeType = "eks.amazonaws.com/compute-type"
+	eksComputeTypeAuto  = "auto"
+)
+
+// getNodesFixedCosts returns the costs of the control plane nodes and the EKS Auto Mode fee of the managed nodes.
+// Only the part of the control plane node cost not requested by the pods is fixed, the pods are charged the rest.
+// Nodes with an unknown cost are skipped.
+func getNodesFixedCosts(nodes []corev1.Node, pods []PodCost, autoModeFeeRatio float64) (costs map[string]float64) {
+	costs = map[string]float64{}
+	requested := map[string]float64{}
+	for _, pod := range pods {
+		requested[pod.Node] += pod.RequestsHourlyCost
+	}
+	for _, node := range nodes {
+		hourlyCost, err := strconv.ParseFloat(node.Annotations[AnnotationNodeHourlyCost], 64)
+		if err != nil {
+			continue
+		}
+		if _, exists := node.Labels[labelControlPlane]; exists {
+			costs[fixedCostControlPlaneNodes] += max(hourlyCost-requested[node.Name], 0)
+		}
+		if node.Labels[labelEKSComputeType] == eksComputeTypeAuto && autoModeFeeRatio > 0 {
+			costs[fixedCostEKSAutoMode] += hourlyCost * autoModeFeeRatio
+		}
+	}
+	return
+}
@@ -0,0 +1,66 @@
+// Copyright 2025 The MoneyPod Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cluster
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/vlasov-y/moneypod/internal/types"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = Describe("getNodesFixedCosts", func() {
+	newNode := func(name string, hourlyCost string, labels map[string]string) corev1.Node {
+		return corev1.Node{ObjectMeta: metav1.ObjectMeta{
+			Name:        name,
+			Labels:      labels,
+			Annotations: map[string]string{AnnotationNodeHourlyCost: hourlyCost},
+		}}
+	}
+
+	It("should sum the control plane nodes and the Auto Mode fee", func() {
+		costs := getNodesFixedCosts([]corev1.Node{
+			newNode("a", "0.2", map[string]string{labelControlPlane: ""}),
+			newNode("b", "0.2", map[string]string{labelControlPlane: ""}),
+			newNode("c", "1.0", map[string]string{labelEKSComputeType: eksComputeTypeAuto}),
+			newNode("d", "0.5", nil),
+			newNode("e", UnknownCost, map[string]string{labelControlPlane: ""}),
+		}, nil, 0.12)
+		Expect(costs).To(HaveLen(2))
+		Expect(costs).To(HaveKeyWithValue(fixedCostControlPlaneNodes, BeNumerically("~", 0.4, 1e-9)))
+		Expect(costs).To(HaveKeyWithValue(fixedCostEKSAutoMode, BeNumerically("~", 0.12, 1e-9)))
+	})
+
+	It("should skip the Auto Mode fee if it is disabled", func() {
+		costs := getNodesFixedCosts([]corev1.Node{
+			newNode("c", "1.0", map[string]string{labelEKSComputeType: eksComputeTypeAuto}),
+		}, nil, 0)
+		Expect(costs).To(BeEmpty())
+	})
+
+	It("should leave the control plane cost requested by the pods to them", func() {
+		costs := getNodesFixedCosts([]corev1.Node{
+			newNode("a", "0.2", map[string]string{labelControlPlane: ""}),
+			newNode("b", "0.2", map[string]string{labelControlPlane: ""}),
+		}, []PodCost{
+			{Node: "a", RequestsHourlyCost: 0.05},
+			{Node: "a", RequestsHourlyCost: 0.1},
+			{Node: "b", RequestsHourlyCost: 0.3},
+			{Node: "c", RequestsHourlyCost: 1},
+		}, 0)
+		Expect(costs).To(HaveKeyWithValue(fixedCostControlPlaneNodes, BeNumerically("~", 0.05, 1e-9)))
+	})
+})
@@ -0,0 +1,107 @@
+// Copyright 2025 The MoneyPod Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package cluster calculates the costs shared by the whole cluster and their attribution to namespaces.
+package cluster
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"k8s.io/client-go/discovery"
+	"k8s.io/client-go/rest"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+// How often the cluster costs are recalculated
+const refreshInterval = time.Minute
+
+// Options configures the cluster costs.
+type Options struct {
+	// Path to the file with the fixed costs declared by the user
+	FixedCostsPath string
+	// EKS control plane fee, applied if the cluster is detected as EKS
+	EKSControlPlaneHourlyCost float64
+	// EKS Auto Mode management fee as a share of the node hourly cost
+	EKSAutoModeFeeRatio float64
+	// Split the fixed costs between namespaces in proportion to their requests cost
+	AllocateFixedCosts bool
+	// Path to the file with the rules of the platform costs shared by the tenant namespaces
+	SharedCostsPath string
+}
+
+// Job periodically exports the cluster fixed costs and their allocation.
+type Job struct {
+	client.Client
+	config *rest.Config
+	opts   Options
+	// Fixed costs declared in the file
+	fixedCosts []fixedCost
+	// Rules of the shared costs declared in the file
+	sharedCostRules []sharedCostRule
+	// Whether the API server is EKS
+	eks bool
+}
+
+// NewJob creates the job and loads the configured fixed and shared costs.
+func NewJob(ctx context.Context, c client.Client, config *rest.Config, opts Options) (job *Job, err error) {
+	job = &Job{Client: c, config: config, opts: opts}
+	if opts.FixedCostsPath != "" {
+		if job.fixedCosts, err = loadFixedCosts(ctx, opts.FixedCostsPath); err != nil {
+			return
+		}
+	}
+	if opts.SharedCostsPath != "" {
+		if job.sharedCostRules, err = loadSharedCostRules(ctx, opts.SharedCostsPath); err != nil {
+			return
+		}
+	}
+	return
+}
+
+// Start detects the cluster type and recalculates the costs every minute until the context is cancelled.
+func (job *Job) Start(ctx context.Context) error {
+	log := logf.FromContext(ctx).WithName("cluster")
+	ctx = logf.IntoContext(ctx, log)
+
+	// EKS version looks like v1.33.4-eks-a737599
+	if clientDiscovery, err := discovery.NewDiscoveryClientForConfig(job.config); err != nil {
+		log.Error(err, "failed to create the discovery client")
+	} else if version, err := clientDiscovery.ServerVersion(); err != nil {
+		log.Error(err, "failed to get the server version")
+	} else {
+		job.eks = strings.Contains(version.GitVersion, "-eks-")
+		log.Info("detected the cluster", "version", version.GitVersion, "eks", job.eks)
+	}
+
+	ticker := time.NewTicker(refreshInterval)
+	defer ticker.Stop()
+	for {
+		if err := job.run(ctx); err != nil {
+			log.Error(err, "failed to update the cluster costs")
+		}
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// NeedLeaderElection runs the job along with the controllers filling the pod costs.
+func (job *Job) NeedLeaderElection() bool {
+	return true
+}
@@ -0,0 +1,110 @@
+// Copyright 2025 The MoneyPod Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cluster
+
+import (
+	"sync"
+
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// PodCost is the pod cost reported by the pod controller for the attribution.
+type PodCost struct {
+	Namespace string
+	Node      string
+	OwnerKind string
+	OwnerName string
+	// Pod labels the shared cost rules select by
+	Labels             map[string]string
+	RequestsHourlyCost float64
+	// Parts of the requests cost for CPU and memory, the rest are extended resources
+	CPURequestsHourlyCost    float64
+	MemoryRequestsHourlyCost float64
+	// Node reference prices the pod usage is charged with
+	CPUCoreHourlyCost   float64
+	MemoryMiBHourlyCost float64
+	// Latest usage cost sampled from the metrics API
+	UsageHourlyCost float64
+}
+
+// Latest costs of the running pods
+var (
+	podCosts      = map[types.NamespacedName]PodCost{}
+	podUsages     = map[types.NamespacedName]float64{}
+	podCostsMutex sync.RWMutex
+)
+
+// SetPodCost saves the latest pod cost
+func SetPodCost(key types.NamespacedName, cost PodCost) {
+	podCostsMutex.Lock()
+	defer podCostsMutex.Unlock()
+	podCosts[key] = cost
+}
+
+// GetPodCost returns the latest pod cost if the pod is known
+func GetPodCost(key types.NamespacedName) (cost PodCost, found bool) {
+	podCostsMutex.RLock()
+	defer podCostsMutex.RUnlock()
+	cost, found = podCosts[key]
+	return
+}
+
+// DeletePodCost forgets the deleted pod
+func DeletePodCost(key types.NamespacedName) {
+	podCostsMutex.Lock()
+	defer podCostsMutex.Unlock()
+	delete(podCosts, key)
+	delete(podUsages, key)
+}
+
+// SetPodUsageHourlyCost saves the latest usage cost of the known pod sampled from the metrics API
+func SetPodUsageHourlyCost(key types.NamespacedName, hourlyCost float64) {
+	podCostsMutex.Lock()
+	defer podCostsMutex.Unlock()
+	if _, found := podCosts[key]; found {
+		podUsages[key] = hourlyCost
+	}
+}
+
+// HasPodUsage tells whether the pod usage was sampled from the metrics API
+func HasPodUsage(key types.NamespacedName) (found bool) {
+	podCostsMutex.RLock()
+	defer podCostsMutex.RUnlock()
+	_, found = podUsages[key]
+	return
+}
+
+// ListPodCosts returns a snapshot of the pod costs by the pod
+func ListPodCosts() (costs map[types.NamespacedName]PodCost) {
+	podCostsMutex.RLock()
+	defer podCostsMutex.RUnlock()
+	costs = make(map[types.NamespacedName]PodCost, len(podCosts))
+	for key, cost := range podCosts {
+		cost.UsageHourlyCost = podUsages[key]
+		costs[key] = cost
+	}
+	return
+}
+
+// getPodCosts returns a snapshot of the pod costs
+func getPodCosts() (costs []PodCost) {
+	podCostsMutex.RLock()
+	defer podCostsMutex.RUnlock()
+	for key, cost := range podCosts {
+		cost.UsageHourlyCost = podUsages[key]
+		costs = append(costs, cost)
+	}
+	return
+}
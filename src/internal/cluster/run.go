@@ -0,0 +1,87 @@
+// Copyright 2025 The MoneyPod Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cluster
+
+import (
+	"context"
+
+	"github.com/vlasov-y/moneypod/internal/monitoring"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+// run recalculates the fixed costs and their allocation to namespaces
+func (job *Job) run(ctx context.Context) (err error) {
+	log := logf.FromContext(ctx)
+
+	nodes := corev1.NodeList{}
+	if err = job.List(ctx, &nodes); err != nil {
+		log.Error(err, "failed to list nodes")
+		return
+	}
+
+	pods := getPodCosts()
+	costs := getNodesFixedCosts(nodes.Items, pods, job.opts.EKSAutoModeFeeRatio)
+	if job.eks && job.opts.EKSControlPlaneHourlyCost > 0 {
+		costs[fixedCostEKSControlPlane] = job.opts.EKSControlPlaneHourlyCost
+	}
+	for _, c := range job.fixedCosts {
+		costs[c.Name] += c.HourlyCost
+	}
+
+	var total float64
+	monitoring.ClusterFixedHourlyCostMetric.Reset()
+	for name, hourlyCost := range costs {
+		monitoring.ClusterFixedHourlyCostMetric.WithLabelValues(name).Set(hourlyCost)
+		total += hourlyCost
+	}
+	log.V(1).Info("cluster fixed costs", "costs", costs, "total", total)
+
+	if job.opts.AllocateFixedCosts {
+		monitoring.NamespaceFixedHourlyCostMetric.Reset()
+		for namespace, hourlyCost := range allocateFixedCosts(total, pods) {
+			monitoring.NamespaceFixedHourlyCostMetric.WithLabelValues(namespace).Set(hourlyCost)
+		}
+	}
+
+	if len(job.sharedCostRules) == 0 {
+		return
+	}
+	namespaceList := corev1.NamespaceList{}
+	if err = job.List(ctx, &namespaceList); err != nil {
+		log.Error(err, "failed to list namespaces")
+		return
+	}
+	namespaces := map[string]labels.Set{}
+	for _, namespace := range namespaceList.Items {
+		namespaces[namespace.Name] = namespace.Labels
+	}
+	own, withShared, shared := allocateSharedCosts(job.sharedCostRules, pods, namespaces)
+	monitoring.NamespaceOwnHourlyCostMetric.Reset()
+	for namespace, hourlyCost := range own {
+		monitoring.NamespaceOwnHourlyCostMetric.WithLabelValues(namespace).Set(hourlyCost)
+	}
+	monitoring.NamespaceWithSharedHourlyCostMetric.Reset()
+	for namespace, hourlyCost := range withShared {
+		monitoring.NamespaceWithSharedHourlyCostMetric.WithLabelValues(namespace).Set(hourlyCost)
+	}
+	monitoring.ClusterSharedHourlyCostMetric.Reset()
+	for rule, hourlyCost := range shared {
+		monitoring.ClusterSharedHourlyCostMetric.WithLabelValues(rule).Set(hourlyCost)
+	}
+	log.V(1).Info("cluster shared costs", "shared", shared)
+	return
+}
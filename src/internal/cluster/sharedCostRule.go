@@ -0,0 +1,125 @@
+// Copyright 2025 The MoneyPod Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cluster
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"slices"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/yaml"
+)
+
+// Splits of the shared cost between the tenant namespaces
+const (
+	sharedSplitEven     = "even"
+	sharedSplitRequests = "requests"
+	sharedSplitUsage    = "usage"
+)
+
+// sharedCostRule selects the platform pods, e.g. kube-system or DaemonSets,
+// whose cost is redistributed to the tenant namespaces. All the set selectors must match.
+type sharedCostRule struct {
+	Name string `json:"name"`
+	// Namespaces selected by name
+	Namespaces []string `json:"namespaces,omitempty"`
+	// Namespaces selected by labels
+	NamespaceSelector *metav1.LabelSelector `json:"namespaceSelector,omitempty"`
+	// Kinds of the pod owners: DaemonSet, etc.
+	OwnerKinds []string `json:"ownerKinds,omitempty"`
+	// Pods selected by labels
+	PodSelector *metav1.LabelSelector `json:"podSelector,omitempty"`
+	// Split between the tenant namespaces: even, requests (default) or usage
+	Split string `json:"split,omitempty"`
+
+	namespaceSelector labels.Selector
+	podSelector       labels.Selector
+}
+
+// loadSharedCostRules reads the list of shared cost rules from the YAML or JSON file
+func loadSharedCostRules(ctx context.Context, path string) (rules []sharedCostRule, err error) {
+	log := logf.FromContext(ctx)
+
+	var data []byte
+	if data, err = os.ReadFile(path); err != nil {
+		log.Error(err, "failed to read the shared costs file", "path", path)
+		return
+	}
+	if err = yaml.UnmarshalStrict(data, &rules); err != nil {
+		log.Error(err, "failed to parse the shared costs file", "path", path)
+		return
+	}
+	// Costs are taken and redistributed by the rule name
+	names := map[string]bool{}
+	for i := range rules {
+		rule := &rules[i]
+		if rule.Name == "" || (len(rule.Namespaces) == 0 && rule.NamespaceSelector == nil &&
+			len(rule.OwnerKinds) == 0 && rule.PodSelector == nil) {
+			err = fmt.Errorf("shared cost rule #%d has no name or selectors", i)
+			log.Error(err, "invalid shared costs file", "path", path)
+			return
+		}
+		if names[rule.Name] {
+			err = fmt.Errorf("shared cost rule %s is defined more than once", rule.Name)
+			log.Error(err, "invalid shared costs file", "path", path)
+			return
+		}
+		names[rule.Name] = true
+		switch rule.Split {
+		case "":
+			rule.Split = sharedSplitRequests
+		case sharedSplitEven, sharedSplitRequests, sharedSplitUsage:
+		default:
+			err = fmt.Errorf("shared cost rule %s has unknown split: %s", rule.Name, rule.Split)
+			log.Error(err, "invalid shared costs file", "path", path)
+			return
+		}
+		if rule.NamespaceSelector != nil {
+			if rule.namespaceSelector, err = metav1.LabelSelectorAsSelector(rule.NamespaceSelector); err != nil {
+				log.Error(err, "invalid namespace selector", "rule", rule.Name)
+				return
+			}
+		}
+		if rule.PodSelector != nil {
+			if rule.podSelector, err = metav1.LabelSelectorAsSelector(rule.PodSelector); err != nil {
+				log.Error(err, "invalid pod selector", "rule", rule.Name)
+				return
+			}
+		}
+	}
+	log.Info("loaded shared cost rules", "path", path, "count", len(rules))
+	return
+}
+
+// matches tells whether the pod in the namespace with the given labels is shared by the rule
+func (rule *sharedCostRule) matches(pod PodCost, namespaceLabels labels.Set) bool {
+	if len(rule.Namespaces) > 0 && !slices.Contains(rule.Namespaces, pod.Namespace) {
+		return false
+	}
+	if rule.namespaceSelector != nil && !rule.namespaceSelector.Matches(namespaceLabels) {
+		return false
+	}
+	if len(rule.OwnerKinds) > 0 && !slices.Contains(rule.OwnerKinds, pod.OwnerKind) {
+		return false
+	}
+	if rule.podSelector != nil && !rule.podSelector.Matches(labels.Set(pod.Labels)) {
+		return false
+	}
+	return true
+}
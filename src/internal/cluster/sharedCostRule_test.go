@@ -0,0 +1,99 @@
+// Copyright 2025 The MoneyPod Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cluster
+
+import (
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+var _ = Describe("loadSharedCostRules", func() {
+	writeSharedCosts := func(content string) string {
+		path := filepath.Join(GinkgoT().TempDir(), "shared-costs.yaml")
+		Expect(os.WriteFile(path, []byte(content), 0o600)).To(Succeed())
+		return path
+	}
+
+	It("should load the valid file", func() {
+		rules, err := loadSharedCostRules(ctx, writeSharedCosts(`
+- name: kube-system
+  namespaces: [kube-system]
+- name: daemonsets
+  ownerKinds: [DaemonSet]
+  split: usage
+`))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(rules).To(HaveLen(2))
+		Expect(rules[0].Split).To(Equal(sharedSplitRequests))
+		Expect(rules[1].Split).To(Equal(sharedSplitUsage))
+	})
+
+	It("should fail on the broken file", func() {
+		for _, content := range []string{
+			`- namespaces: [kube-system]`,
+			`- name: kube-system`,
+			`- name: kube-system
+  namespaces: [kube-system]
+  split: unknown`,
+			`- name: kube-system
+  namespaceSelector:
+    matchExpressions:
+    - {key: team, operator: Unknown}`,
+			`- name: kube-system
+  namespaces: [kube-system]
+  unknownField: value`,
+			`not a list`,
+			`- name: platform
+  namespaces: [kube-system]
+- name: platform
+  namespaces: [monitoring]`,
+		} {
+			By(content)
+			_, err := loadSharedCostRules(ctx, writeSharedCosts(content))
+			Expect(err).To(HaveOccurred())
+		}
+	})
+})
+
+var _ = Describe("sharedCostRule.matches", func() {
+	It("should require all the selectors to match", func() {
+		rules, err := loadSharedCostRules(ctx, func() string {
+			path := filepath.Join(GinkgoT().TempDir(), "shared-costs.yaml")
+			Expect(os.WriteFile(path, []byte(`
+- name: platform
+  namespaceSelector:
+    matchLabels: {tier: platform}
+  ownerKinds: [DaemonSet]
+  podSelector:
+    matchLabels: {app: agent}
+`), 0o600)).To(Succeed())
+			return path
+		}())
+		Expect(err).ToNot(HaveOccurred())
+		rule := rules[0]
+		platform := labels.Set{"tier": "platform"}
+		pod := PodCost{Namespace: "monitoring", OwnerKind: "DaemonSet", Labels: map[string]string{"app": "agent"}}
+
+		Expect(rule.matches(pod, platform)).To(BeTrue())
+		Expect(rule.matches(pod, labels.Set{"tier": "tenant"})).To(BeFalse())
+		Expect(rule.matches(PodCost{Namespace: "monitoring", OwnerKind: "ReplicaSet", Labels: pod.Labels}, platform)).
+			To(BeFalse())
+		Expect(rule.matches(PodCost{Namespace: "monitoring", OwnerKind: "DaemonSet"}, platform)).To(BeFalse())
+	})
+})
@@ -15,6 +15,8 @@
 package node
 
 import (
+	"strconv"
+
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/vlasov-y/moneypod/internal/monitoring"
 	"github.com/vlasov-y/moneypod/internal/types"
@@ -25,12 +27,54 @@ func deleteNodeMetrics(node *corev1.Node) {
 	monitoring.NodeHourlyCostMetric.DeletePartialMatch(prometheus.Labels{
 		"name": node.Name,
 	})
+	monitoring.NodeStorageHourlyCostMetric.DeletePartialMatch(prometheus.Labels{
+		"name": node.Name,
+	})
+	monitoring.NodeCorrectionFactorMetric.DeletePartialMatch(prometheus.Labels{
+		"name": node.Name,
+	})
+	monitoring.NodeInvoicedHourlyCostMetric.DeletePartialMatch(prometheus.Labels{
+		"name": node.Name,
+	})
+	monitoring.NodeIdleHourlyCostMetric.DeletePartialMatch(prometheus.Labels{
+		"name": node.Name,
+	})
+	monitoring.NodeReservedHourlyCostMetric.DeletePartialMatch(prometheus.Labels{
+		"name": node.Name,
+	})
 }
 
 func createNodeMetrics(node *corev1.Node, cost float64, info *types.NodeInfo) {
-	deleteNodeMetrics(node)
+	monitoring.NodeHourlyCostMetric.DeletePartialMatch(prometheus.Labels{
+		"name": node.Name,
+	})
 	monitoring.NodeHourlyCostMetric.WithLabelValues(
 		node.Name, node.Name, info.Type, info.Capacity,
-		info.ID, info.AvailabilityZone,
+		info.ID, info.AvailabilityZone, info.Currency, info.License, info.Tenancy,
 	).Set(cost)
+
+	// Storage cost is known only for the nodes the provider prices the disks of
+	monitoring.NodeStorageHourlyCostMetric.DeletePartialMatch(prometheus.Labels{
+		"name": node.Name,
+	})
+	if storage, err := strconv.ParseFloat(node.Annotations[types.AnnotationNodeStorageHourlyCost], 64); err == nil {
+		monitoring.NodeStorageHourlyCostMetric.WithLabelValues(node.Name, node.Name).Set(storage)
+	}
+
+	// Invoiced cost is known only for the nodes found in the Cost and Usage Report
+	monitoring.NodeCorrectionFactorMetric.DeletePartialMatch(prometheus.Labels{
+		"name": node.Name,
+	})
+	monitoring.NodeInvoicedHourlyCostMetric.DeletePartialMatch(prometheus.Labels{
+		"name": node.Name,
+	})
+	factor, err := strconv.ParseFloat(node.Annotations[types.AnnotationNodeCorrectionFactor], 64)
+	if err != nil {
+		return
+	}
+	monitoring.NodeCorrectionFactorMetric.WithLabelValues(node.Name, node.Name, info.ID).Set(factor)
+	monitoring.NodeInvoicedHourlyCostMetric.WithLabelValues(
+		node.Name, node.Name, info.Type, info.Capacity,
+		info.ID, info.AvailabilityZone, info.Currency, info.License, info.Tenancy,
+	).Set(cost * factor)
 }
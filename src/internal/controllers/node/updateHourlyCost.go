@@ -34,7 +34,9 @@ func (r *NodeReconciler) updateHourlyCost(ctx context.Context, node *corev1.Node
 
 	annotations := node.GetAnnotations()
 	if annotations == nil {
+		// Provider may annotate the node as well
 		annotations = map[string]string{}
+		node.SetAnnotations(annotations)
 	}
 
 	// Check hourly cost update condition transition time
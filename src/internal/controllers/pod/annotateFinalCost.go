@@ -0,0 +1,78 @@
+// Copyright 2025 The MoneyPod Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pod
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/vlasov-y/moneypod/internal/cluster"
+	. "github.com/vlasov-y/moneypod/internal/types"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/retry"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+// annotateFinalCost writes the requests cost of the terminated pod for its runtime on the pod
+// and adds it to the owning Job, so the cost is kept after the pod is garbage-collected
+func (r *PodReconciler) annotateFinalCost(ctx context.Context, pod *corev1.Pod, cost cluster.PodCost) (err error) {
+	log := logf.FromContext(ctx)
+
+	runtime := getPodRuntime(pod)
+	finalCost := cost.RequestsHourlyCost * runtime.Hours()
+
+	// Pod annotation marks the cost as recorded, so it is written first.
+	// Optimistic lock fails the patch if the cached pod is stale and may be annotated already,
+	// so the cost is never added to the Job twice
+	patch := client.MergeFromWithOptions(pod.DeepCopy(), client.MergeFromWithOptimisticLock{})
+	metav1.SetMetaDataAnnotation(&pod.ObjectMeta, AnnotationFinalCost, strconv.FormatFloat(finalCost, 'f', 10, 64))
+	if err = r.Patch(ctx, pod, patch); err != nil {
+		if !errors.IsConflict(err) {
+			log.Error(err, "failed to annotate the pod with its final cost")
+		}
+		return
+	}
+	log.Info("pod final cost", "cost", finalCost, "runtime", runtime.String())
+
+	if cost.OwnerKind != "Job" {
+		return
+	}
+	// Job sums up the costs of all its pods, retries included
+	if err = retry.RetryOnConflict(retry.DefaultRetry, func() (err error) {
+		job := batchv1.Job{}
+		if err = r.Get(ctx, types.NamespacedName{Namespace: pod.Namespace, Name: cost.OwnerName}, &job); err != nil {
+			return
+		}
+		jobCost, _ := strconv.ParseFloat(job.GetAnnotations()[AnnotationFinalCost], 64)
+		metav1.SetMetaDataAnnotation(&job.ObjectMeta, AnnotationFinalCost,
+			strconv.FormatFloat(jobCost+finalCost, 'f', 10, 64))
+		return r.Update(ctx, &job)
+	}); err != nil {
+		// Job may be deleted before its pods
+		if errors.IsNotFound(err) {
+			return nil
+		}
+		log.Error(err, "failed to annotate the job with its final cost")
+		r.Recorder.Eventf(pod, corev1.EventTypeWarning, "UpdateJobFailed", err.Error())
+		// Pod is annotated already, so the job is not retried
+		return nil
+	}
+	return
+}
@@ -17,14 +17,19 @@ package pod
 
 import (
 	"context"
+	"fmt"
+	"math"
+	"sync"
 	"time"
 
+	"github.com/vlasov-y/moneypod/internal/cluster"
 	. "github.com/vlasov-y/moneypod/internal/types"
 	. "github.com/vlasov-y/moneypod/internal/utils"
 
-	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -33,41 +38,89 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 )
 
+// PodOptions configures the pod cost calculation.
+type PodOptions struct {
+	// Share of the node cost per extended resource: nvidia.com/gpu, etc.
+	ExtendedResourceShares ResourceShares
+	// Strategy of the node cost split between CPU and memory: equal-units, ratio or sku
+	SplitStrategy string
+	// GiBs of memory 1 CPU core costs for the ratio strategy
+	CPUMemoryCostRatio float64
+	// Path to the file with the per-vCPU and per-GiB prices for the sku strategy
+	CPUMemorySKUsPath string
+	// Policy for the containers without CPU or memory requests: none, limits, limitrange or minimum
+	RequestsPolicy string
+	// CPU and memory charged for the missing requests by the minimum policy
+	MinimumCPURequest    string
+	MinimumMemoryRequest string
+	// Export the cost per container, disabled on clusters with high cardinality
+	ContainerMetrics bool
+	// Estimate of the unscheduled pod cost over the nodes it fits: cheapest or median
+	PendingEstimate string
+	// Node resources the node cost is divided by: allocatable or capacity
+	PricingBasis string
+}
+
 // PodReconciler reconciles a Pod object
 type PodReconciler struct {
 	Reconciler
+	Options PodOptions
+	// SKUs loaded for the sku split strategy
+	skus []cpuMemorySKU
+	// Requests parsed for the minimum requests policy
+	minimumRequests corev1.ResourceList
+	// Reference prices of the nodes by the name, shared by the pending pods estimates
+	nodeRefHourlyCosts      map[string]nodeRefHourlyCost
+	nodeRefHourlyCostsMutex sync.Mutex
 }
 
-// +kubebuilder:rbac:groups="",resources=pods,verbs=get;list;watch
+// +kubebuilder:rbac:groups="",resources=pods,verbs=get;list;watch;update;patch
 // +kubebuilder:rbac:groups="",resources=pods/status,verbs=get;list;update;patch
 // +kubebuilder:rbac:groups="apps",resources=statefulsets,verbs=get;list;watch
 // +kubebuilder:rbac:groups="apps",resources=deployments,verbs=get;list;watch
 // +kubebuilder:rbac:groups="apps",resources=replicasets,verbs=get;list;watch
-// +kubebuilder:rbac:groups="batch",resources=jobs,verbs=get;list;watch
+// +kubebuilder:rbac:groups="batch",resources=jobs,verbs=get;list;watch;update;patch
 // +kubebuilder:rbac:groups="batch",resources=cronjobs,verbs=get;list;watch
 // +kubebuilder:rbac:groups=metrics.k8s.io,resources=pods,verbs=get;list;watch
+// +kubebuilder:rbac:groups="",resources=limitranges,verbs=get;list;watch
+// +kubebuilder:rbac:groups="",resources=namespaces,verbs=get;list;watch
 
 func (r *PodReconciler) Reconcile(ctx context.Context, req ctrl.Request) (result ctrl.Result, err error) {
 	log := logf.FromContext(ctx)
 
 	pod := corev1.Pod{}
 	if err = r.Get(ctx, req.NamespacedName, &pod); err != nil {
-		// Object does not exist, ignore the event and return
 		if !errors.IsNotFound(err) {
 			log.Error(err, "cannot get the pod")
+			return
 		}
-		return result, client.IgnoreNotFound(err)
+		// Object does not exist, drop its costs and return
+		deletePodMetrics(&corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: req.Name, Namespace: req.Namespace}})
+		r.deletePodCost(ctx, req.NamespacedName)
+		return result, nil
 	}
 	log = log.WithValues("pod", pod.Name)
 
-	// Pod is not yet scheduled
+	// Terminated pods are not charged anymore, even if they are being deleted already
+	if pod.Status.Phase == corev1.PodSucceeded || pod.Status.Phase == corev1.PodFailed {
+		if err = r.reconcileTerminated(ctx, &pod); CheckRequeue(err) {
+			// Node is not priced yet
+			return RequeueResult, nil
+		}
+		return
+	}
+
+	// Pod is not yet scheduled, binding to a node triggers the reconciliation again.
+	// Meanwhile the estimate is refreshed along with the node prices
 	if pod.Spec.NodeName == "" {
-		return RequeueResult, err
+		err = r.reconcilePending(ctx, &pod)
+		return ctrl.Result{RequeueAfter: CostRefreshInterval}, err
 	}
 
 	// Handle deletion
 	if pod.GetDeletionTimestamp() != nil {
 		deletePodMetrics(&pod)
+		r.deletePodCost(ctx, req.NamespacedName)
 		return
 	}
 
@@ -98,46 +151,125 @@ func (r *PodReconciler) Reconcile(ctx context.Context, req ctrl.Request) (result
 	}
 
 	// Calculate node's reference costs
-	info.NodeCPUCoreHourlyCost, info.NodeMemoryMiBHourlyCost = r.getResourcesRefHourlyCost(&node, info.NodeHourlyCost)
+	var cpuMemoryCostRatio float64
+	cpuMemoryCostRatio, info.SplitStrategy = r.getCPUMemoryCostRatio(ctx, &node)
+	info.NodeCPUCoreHourlyCost, info.NodeMemoryMiBHourlyCost, info.NodeExtendedResourceHourlyCosts =
+		r.getResourcesRefHourlyCost(&node, info.NodeHourlyCost, r.getExtendedResourceShares(ctx, &node), cpuMemoryCostRatio)
+	r.updateNodeReservedHourlyCost(&node, info.NodeCPUCoreHourlyCost, info.NodeMemoryMiBHourlyCost,
+		info.NodeExtendedResourceHourlyCosts)
+
+	// Price the resources allocated by the kubelet and fill the missing requests according to the policy
+	var requestsPod *corev1.Pod
+	if requestsPod, info.RequestsPolicy, err = r.imputeRequests(ctx, r.getAllocatedPod(&pod)); err != nil {
+		return
+	}
 
 	// Calculate minimum pod hourly cost basing on resources requests
-	info.PodRequestsHourlyCost = r.getRequestsHourlyCost(ctx, &pod, info.NodeCPUCoreHourlyCost, info.NodeMemoryMiBHourlyCost)
+	info.PodRequestsHourlyCost, info.PodResourcesHourlyCosts = r.getRequestsHourlyCost(ctx, requestsPod,
+		info.NodeCPUCoreHourlyCost, info.NodeMemoryMiBHourlyCost, info.NodeExtendedResourceHourlyCosts)
+
+	if r.Options.ContainerMetrics {
+		info.ContainersRequestsHourlyCosts = r.getContainersRequestsHourlyCost(requestsPod,
+			info.NodeCPUCoreHourlyCost, info.NodeMemoryMiBHourlyCost, info.NodeExtendedResourceHourlyCosts)
+	}
 
 	// Get owner
-	if len(pod.GetOwnerReferences()) > 0 {
-		ownerRef := pod.GetOwnerReferences()[0]
-		info.Owner.Kind = ownerRef.Kind
-		info.Owner.Name = ownerRef.Name
-		// Get Deployment name for ReplicaSet
-		if ownerRef.Kind == "ReplicaSet" {
-			replicaset := appsv1.ReplicaSet{}
-			if err = r.Get(ctx, types.NamespacedName{Namespace: pod.Namespace, Name: ownerRef.Name}, &replicaset); err != nil {
-				// Object does not exist, ignore the event and return
-				if !errors.IsNotFound(err) {
-					log.Error(err, "cannot get the replicaset")
-				}
-				return result, client.IgnoreNotFound(err)
-			}
-			// Copy ReplicaSet owner to pod info
-			if len(replicaset.GetOwnerReferences()) > 0 {
-				ownerRef = replicaset.GetOwnerReferences()[0]
-				info.Owner.Kind = ownerRef.Kind
-				info.Owner.Name = ownerRef.Name
-			}
-		}
+	if info.Owner.Kind, info.Owner.Name, err = r.getPodOwner(ctx, &pod); err != nil {
+		return result, client.IgnoreNotFound(err)
 	}
 
 	// Update metrics
 	createPodMetrics(&pod, &info)
+	// Requests cost changes with the same node prices only if the pod is resized
+	if previous, found := cluster.GetPodCost(req.NamespacedName); found &&
+		previous.CPUCoreHourlyCost == info.NodeCPUCoreHourlyCost &&
+		previous.MemoryMiBHourlyCost == info.NodeMemoryMiBHourlyCost &&
+		math.Abs(previous.RequestsHourlyCost-info.PodRequestsHourlyCost) > 1e-9 {
+		r.Recorder.Eventf(&pod, corev1.EventTypeNormal, "HourlyCostChanged", "requests hourly cost changed from %f to %f",
+			previous.RequestsHourlyCost, info.PodRequestsHourlyCost)
+	}
+
+	// Share the cost for the attribution of the cluster costs
+	cluster.SetPodCost(req.NamespacedName, cluster.PodCost{
+		Namespace:                pod.Namespace,
+		Node:                     pod.Spec.NodeName,
+		OwnerKind:                info.Owner.Kind,
+		OwnerName:                info.Owner.Name,
+		Labels:                   pod.Labels,
+		RequestsHourlyCost:       info.PodRequestsHourlyCost,
+		CPURequestsHourlyCost:    info.PodResourcesHourlyCosts[corev1.ResourceCPU.String()],
+		MemoryRequestsHourlyCost: info.PodResourcesHourlyCosts[corev1.ResourceMemory.String()],
+		CPUCoreHourlyCost:        info.NodeCPUCoreHourlyCost,
+		MemoryMiBHourlyCost:      info.NodeMemoryMiBHourlyCost,
+	})
+	r.updateNodeIdleHourlyCost(ctx, node.Name)
 
 	return
 }
 
 // SetupWithManager sets up the controller with the Manager.
-func (r *PodReconciler) SetupWithManager(mgr ctrl.Manager) error {
+func (r *PodReconciler) SetupWithManager(mgr ctrl.Manager) (err error) {
+	// Validate the split strategy
+	switch r.Options.SplitStrategy {
+	case "", SplitEqualUnits:
+		r.Options.SplitStrategy = SplitEqualUnits
+	case SplitRatio:
+		if r.Options.CPUMemoryCostRatio <= 0 {
+			return fmt.Errorf("CPU to memory cost ratio must be positive, got %v", r.Options.CPUMemoryCostRatio)
+		}
+	case SplitSKU:
+		if r.Options.CPUMemorySKUsPath == "" {
+			return fmt.Errorf("SKUs file is required for the sku split strategy")
+		}
+		if r.skus, err = loadCPUMemorySKUs(context.Background(), r.Options.CPUMemorySKUsPath); err != nil {
+			return
+		}
+	default:
+		return fmt.Errorf("unknown CPU and memory split strategy: %s", r.Options.SplitStrategy)
+	}
+
+	// Validate the pending pods estimate
+	switch r.Options.PendingEstimate {
+	case "":
+		r.Options.PendingEstimate = PendingEstimateCheapest
+	case PendingEstimateCheapest, PendingEstimateMedian:
+	default:
+		return fmt.Errorf("unknown pending pods estimate: %s", r.Options.PendingEstimate)
+	}
+
+	// Validate the pricing basis
+	switch r.Options.PricingBasis {
+	case "":
+		r.Options.PricingBasis = PricingBasisAllocatable
+	case PricingBasisAllocatable, PricingBasisCapacity:
+	default:
+		return fmt.Errorf("unknown pricing basis: %s", r.Options.PricingBasis)
+	}
+
+	// Validate the requests policy
+	switch r.Options.RequestsPolicy {
+	case "", RequestsPolicyNone:
+		r.Options.RequestsPolicy = RequestsPolicyNone
+	case RequestsPolicyLimits, RequestsPolicyLimitRange:
+	case RequestsPolicyMinimum:
+		r.minimumRequests = corev1.ResourceList{}
+		for name, value := range map[corev1.ResourceName]string{
+			corev1.ResourceCPU:    r.Options.MinimumCPURequest,
+			corev1.ResourceMemory: r.Options.MinimumMemoryRequest,
+		} {
+			var quantity resource.Quantity
+			if quantity, err = resource.ParseQuantity(value); err != nil {
+				return fmt.Errorf("invalid minimum %s request %q: %w", name, value, err)
+			}
+			r.minimumRequests[name] = quantity
+		}
+	default:
+		return fmt.Errorf("unknown requests policy: %s", r.Options.RequestsPolicy)
+	}
+
 	// Register index: spec.nodeName → pod
 	if err := mgr.GetFieldIndexer().IndexField(context.Background(),
-		&corev1.Pod{}, ".spec.nodeName",
+		&corev1.Pod{}, indexNodeName,
 		func(obj client.Object) []string {
 			pod := obj.(*corev1.Pod)
 			if pod.Spec.NodeName == "" {
@@ -149,6 +281,7 @@ func (r *PodReconciler) SetupWithManager(mgr ctrl.Manager) error {
 	}
 
 	return ctrl.NewControllerManagedBy(mgr).
+		// Any pod update is reconciled, status ones included: allocated resources and resize conditions
 		For(&corev1.Pod{}).
 		// Watch Nodes in case of cost update and enqueue...
 		// ... for reconciliation only required Pods
@@ -169,7 +302,7 @@ func (r *PodReconciler) SetupWithManager(mgr ctrl.Manager) error {
 					if time.Since(t).Seconds() < 10 {
 						// List Pods on this node
 						var pods corev1.PodList
-						if err := r.Client.List(ctx, &pods, client.MatchingFields{".spec.nodeName": node.Name}); err != nil {
+						if err := r.Client.List(ctx, &pods, client.MatchingFields{indexNodeName: node.Name}); err != nil {
 							return nil
 						}
 						// Prepare reconciliation requests
@@ -18,9 +18,11 @@ import (
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	. "github.com/vlasov-y/moneypod/internal/types"
-	. "github.com/vlasov-y/moneypod/internal/utils"
 	. "github.com/vlasov-y/moneypod/test/utils"
 
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/vlasov-y/moneypod/internal/monitoring"
+
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
@@ -176,10 +178,14 @@ var _ = Describe("PodReconciler", Ordered, func() {
 			Expect(c.Create(ctx, pod)).To(Succeed())
 		})
 
-		It("should requeue the reconciliation", func() {
+		It("should estimate the cost on the nodes it fits and refresh it with the node prices", func() {
 			result, err := reconciler.Reconcile(ctx, req)
 			ExpectWithOffset(1, err).NotTo(HaveOccurred())
-			ExpectWithOffset(2, result).To(Equal(RequeueResult))
+			ExpectWithOffset(2, result).To(Equal(ctrl.Result{RequeueAfter: CostRefreshInterval}))
+			// Pod requests the whole node
+			Expect(testutil.ToFloat64(monitoring.PodPendingEstimatedHourlyCostMetric.WithLabelValues(
+				pod.Name, pod.Name, pod.Namespace, "", "", PendingEstimateCheapest,
+			))).To(BeNumerically("~", 10, 1e-6))
 		})
 	})
 
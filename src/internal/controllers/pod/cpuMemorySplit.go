@@ -0,0 +1,67 @@
+// Copyright 2025 The MoneyPod Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pod
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/yaml"
+)
+
+// Strategies of the node cost split between CPU and memory
+const (
+	// 1 CPU core costs the same as 1 GiB of memory
+	SplitEqualUnits = "equal-units"
+	// 1 CPU core costs the configured number of GiB of memory
+	SplitRatio = "ratio"
+	// Ratio of the cloud per-vCPU and per-GiB prices of the node instance family
+	SplitSKU = "sku"
+	// Ratio from the node annotation, overrides any strategy
+	SplitAnnotation = "annotation"
+)
+
+// cpuMemorySKU is the published price of a vCPU and a GiB of memory of the instance family, e.g. GCP e2.
+type cpuMemorySKU struct {
+	Family              string  `json:"family"`
+	CPUCoreHourlyCost   float64 `json:"cpuCoreHourlyCost"`
+	MemoryGiBHourlyCost float64 `json:"memoryGiBHourlyCost"`
+}
+
+// loadCPUMemorySKUs reads the list of SKUs from the YAML or JSON file
+func loadCPUMemorySKUs(ctx context.Context, path string) (skus []cpuMemorySKU, err error) {
+	log := logf.FromContext(ctx)
+
+	var data []byte
+	if data, err = os.ReadFile(path); err != nil {
+		log.Error(err, "failed to read the SKUs file", "path", path)
+		return
+	}
+	if err = yaml.UnmarshalStrict(data, &skus); err != nil {
+		log.Error(err, "failed to parse the SKUs file", "path", path)
+		return
+	}
+	for i, sku := range skus {
+		if sku.Family == "" || sku.CPUCoreHourlyCost <= 0 || sku.MemoryGiBHourlyCost <= 0 {
+			err = fmt.Errorf("SKU #%d has no family or positive costs", i)
+			log.Error(err, "invalid SKUs file", "path", path)
+			return
+		}
+	}
+	log.Info("loaded SKUs", "path", path, "count", len(skus))
+	return
+}
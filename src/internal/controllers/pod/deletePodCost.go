@@ -0,0 +1,38 @@
+// Copyright 2025 The MoneyPod Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pod
+
+import (
+	"context"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/vlasov-y/moneypod/internal/cluster"
+	"github.com/vlasov-y/moneypod/internal/monitoring"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// deletePodCost forgets the pod cost and updates the idle cost of the node it ran on
+func (r *PodReconciler) deletePodCost(ctx context.Context, key types.NamespacedName) {
+	// Effective cost of the pods never sampled is not known to the usage job
+	monitoring.PodEffectiveHourlyCostMetric.DeletePartialMatch(prometheus.Labels{
+		"name": key.Name, "namespace": key.Namespace,
+	})
+	cost, found := cluster.GetPodCost(key)
+	if !found {
+		return
+	}
+	cluster.DeletePodCost(key)
+	r.updateNodeIdleHourlyCost(ctx, cost.Node)
+}
@@ -0,0 +1,58 @@
+// Copyright 2025 The MoneyPod Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pod
+
+import (
+	corev1 "k8s.io/api/core/v1"
+)
+
+// getAllocatedPod returns the copy of the pod with the container requests the kubelet has allocated,
+// which differ from the spec while an in-place resize is pending or in progress
+func (r *PodReconciler) getAllocatedPod(pod *corev1.Pod) (allocated *corev1.Pod) {
+	allocated = pod.DeepCopy()
+
+	statuses := map[string]*corev1.ContainerStatus{}
+	for _, containerStatuses := range [][]corev1.ContainerStatus{
+		allocated.Status.InitContainerStatuses, allocated.Status.ContainerStatuses,
+	} {
+		for i := range containerStatuses {
+			statuses[containerStatuses[i].Name] = &containerStatuses[i]
+		}
+	}
+
+	for _, containers := range [][]corev1.Container{allocated.Spec.InitContainers, allocated.Spec.Containers} {
+		for i := range containers {
+			status, exists := statuses[containers[i].Name]
+			if !exists {
+				continue
+			}
+			// Allocated resources are reported by the kubelet, actual ones by the runtime if the former are not
+			requests := status.AllocatedResources
+			if len(requests) == 0 && status.Resources != nil {
+				requests = status.Resources.Requests
+			}
+			if len(requests) == 0 {
+				continue
+			}
+			if containers[i].Resources.Requests == nil {
+				containers[i].Resources.Requests = corev1.ResourceList{}
+			}
+			for name, quantity := range requests {
+				containers[i].Resources.Requests[name] = quantity
+			}
+		}
+	}
+	return
+}
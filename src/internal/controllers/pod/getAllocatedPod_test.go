@@ -0,0 +1,48 @@
+// Copyright 2025 The MoneyPod Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pod
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+var _ = Describe("getAllocatedPod", func() {
+	requests := func(cpu string) corev1.ResourceList {
+		return corev1.ResourceList{corev1.ResourceCPU: resource.MustParse(cpu)}
+	}
+
+	It("should take the allocated requests over the spec", func() {
+		pod := &corev1.Pod{
+			Spec: corev1.PodSpec{Containers: []corev1.Container{
+				{Name: "app", Resources: corev1.ResourceRequirements{Requests: requests("2")}},
+				{Name: "sidecar", Resources: corev1.ResourceRequirements{Requests: requests("100m")}},
+				{Name: "new"},
+			}},
+			Status: corev1.PodStatus{ContainerStatuses: []corev1.ContainerStatus{
+				{Name: "app", AllocatedResources: requests("1")},
+				{Name: "sidecar", Resources: &corev1.ResourceRequirements{Requests: requests("200m")}},
+			}},
+		}
+		allocated := reconciler.getAllocatedPod(pod)
+		Expect(allocated.Spec.Containers[0].Resources.Requests.Cpu().String()).To(Equal("1"))
+		Expect(allocated.Spec.Containers[1].Resources.Requests.Cpu().String()).To(Equal("200m"))
+		Expect(allocated.Spec.Containers[2].Resources.Requests).To(BeNil())
+		// Original pod is not modified
+		Expect(pod.Spec.Containers[0].Resources.Requests.Cpu().String()).To(Equal("2"))
+	})
+})
@@ -0,0 +1,54 @@
+// Copyright 2025 The MoneyPod Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pod
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	. "github.com/vlasov-y/moneypod/internal/types"
+	corev1 "k8s.io/api/core/v1"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+// getCPUMemoryCostRatio returns how many GiB of memory 1 CPU core of the node costs and the strategy it comes from.
+// Strategies fall back to the equal units if they have nothing for the node.
+func (r *PodReconciler) getCPUMemoryCostRatio(ctx context.Context, node *corev1.Node) (ratio float64, strategy string) {
+	log := logf.FromContext(ctx)
+
+	if value, exists := node.GetAnnotations()[AnnotationNodeCPUMemoryCostRatio]; exists {
+		if ratio, err := strconv.ParseFloat(value, 64); err == nil && ratio > 0 {
+			return ratio, SplitAnnotation
+		}
+		log.Info("invalid CPU to memory cost ratio annotation, ignoring it", "value", value)
+	}
+
+	switch r.Options.SplitStrategy {
+	case SplitRatio:
+		return r.Options.CPUMemoryCostRatio, SplitRatio
+	case SplitSKU:
+		// Family is the instance type prefix: r5.large, e2-standard-4
+		instanceType := node.GetLabels()[corev1.LabelInstanceTypeStable]
+		family, _, _ := strings.Cut(strings.ReplaceAll(instanceType, "-", "."), ".")
+		for _, sku := range r.skus {
+			if sku.Family == family {
+				return sku.CPUCoreHourlyCost / sku.MemoryGiBHourlyCost, SplitSKU
+			}
+		}
+		log.V(1).Info("no SKU for the node instance family, using equal units", "family", family)
+	}
+	return 1, SplitEqualUnits
+}
@@ -0,0 +1,109 @@
+// Copyright 2025 The MoneyPod Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pod
+
+import (
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/vlasov-y/moneypod/internal/types"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = Describe("getCPUMemoryCostRatio", func() {
+	newNode := func(instanceType string, annotations map[string]string) *corev1.Node {
+		return &corev1.Node{ObjectMeta: metav1.ObjectMeta{
+			Labels:      map[string]string{corev1.LabelInstanceTypeStable: instanceType},
+			Annotations: annotations,
+		}}
+	}
+
+	It("should use equal units by default", func() {
+		r := &PodReconciler{}
+		ratio, strategy := r.getCPUMemoryCostRatio(ctx, newNode("m5.large", nil))
+		Expect(ratio).To(Equal(1.0))
+		Expect(strategy).To(Equal(SplitEqualUnits))
+	})
+
+	It("should use the configured ratio", func() {
+		r := &PodReconciler{Options: PodOptions{SplitStrategy: SplitRatio, CPUMemoryCostRatio: 8}}
+		ratio, strategy := r.getCPUMemoryCostRatio(ctx, newNode("m5.large", nil))
+		Expect(ratio).To(Equal(8.0))
+		Expect(strategy).To(Equal(SplitRatio))
+	})
+
+	It("should derive the ratio from the instance family SKU", func() {
+		r := &PodReconciler{
+			Options: PodOptions{SplitStrategy: SplitSKU},
+			skus:    []cpuMemorySKU{{Family: "e2", CPUCoreHourlyCost: 0.02, MemoryGiBHourlyCost: 0.0025}},
+		}
+		ratio, strategy := r.getCPUMemoryCostRatio(ctx, newNode("e2-standard-4", nil))
+		Expect(ratio).To(BeNumerically("~", 8, 1e-9))
+		Expect(strategy).To(Equal(SplitSKU))
+
+		ratio, strategy = r.getCPUMemoryCostRatio(ctx, newNode("n2-standard-4", nil))
+		Expect(ratio).To(Equal(1.0))
+		Expect(strategy).To(Equal(SplitEqualUnits))
+	})
+
+	It("should prefer the node annotation", func() {
+		r := &PodReconciler{Options: PodOptions{SplitStrategy: SplitRatio, CPUMemoryCostRatio: 8}}
+		ratio, strategy := r.getCPUMemoryCostRatio(ctx, newNode("r5.large", map[string]string{
+			AnnotationNodeCPUMemoryCostRatio: "4",
+		}))
+		Expect(ratio).To(Equal(4.0))
+		Expect(strategy).To(Equal(SplitAnnotation))
+
+		ratio, strategy = r.getCPUMemoryCostRatio(ctx, newNode("r5.large", map[string]string{
+			AnnotationNodeCPUMemoryCostRatio: "broken",
+		}))
+		Expect(ratio).To(Equal(8.0))
+		Expect(strategy).To(Equal(SplitRatio))
+	})
+})
+
+var _ = Describe("loadCPUMemorySKUs", func() {
+	writeSKUs := func(content string) string {
+		path := filepath.Join(GinkgoT().TempDir(), "skus.yaml")
+		Expect(os.WriteFile(path, []byte(content), 0o600)).To(Succeed())
+		return path
+	}
+
+	It("should load the valid file", func() {
+		skus, err := loadCPUMemorySKUs(ctx, writeSKUs(`
+- family: e2
+  cpuCoreHourlyCost: 0.021811
+  memoryGiBHourlyCost: 0.002923
+`))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(skus).To(Equal([]cpuMemorySKU{{"e2", 0.021811, 0.002923}}))
+	})
+
+	It("should fail on the broken file", func() {
+		for _, content := range []string{
+			`- family: e2`,
+			`- cpuCoreHourlyCost: 0.02
+  memoryGiBHourlyCost: 0.002`,
+			`not a list`,
+		} {
+			By(content)
+			_, err := loadCPUMemorySKUs(ctx, writeSKUs(content))
+			Expect(err).To(HaveOccurred())
+		}
+	})
+})
@@ -0,0 +1,57 @@
+// Copyright 2025 The MoneyPod Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pod
+
+import (
+	"slices"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/utils/ptr"
+)
+
+// getContainersRequestsHourlyCost prices the requests of every running container, native sidecars included.
+// Init containers exit before the pod starts, so they are skipped.
+func (r *PodReconciler) getContainersRequestsHourlyCost(pod *corev1.Pod,
+	cpuCoreHourlyCost float64, memoryMiBHourlyCost float64,
+	extendedUnitHourlyCosts map[string]float64) (hourlyCosts map[string]float64) {
+	// Define base resource units
+	cpuCore := resource.MustParse("1.0")
+	memoryMiB := resource.MustParse("1Mi")
+	cpuCoreFloat := cpuCore.AsApproximateFloat64()
+	memoryMiBFloat := memoryMiB.AsApproximateFloat64()
+
+	// Restartable init containers are the sidecars running along with the containers
+	running := slices.Clone(pod.Spec.Containers)
+	for _, container := range pod.Spec.InitContainers {
+		if ptr.Deref(container.RestartPolicy, "") == corev1.ContainerRestartPolicyAlways {
+			running = append(running, container)
+		}
+	}
+
+	hourlyCosts = map[string]float64{}
+	for _, container := range running {
+		requests := container.Resources.Requests
+		hourlyCost := requests.Cpu().AsApproximateFloat64() / cpuCoreFloat * cpuCoreHourlyCost
+		hourlyCost += requests.Memory().AsApproximateFloat64() / memoryMiBFloat * memoryMiBHourlyCost
+		for name, unitHourlyCost := range extendedUnitHourlyCosts {
+			if quantity, exists := requests[corev1.ResourceName(name)]; exists {
+				hourlyCost += quantity.AsApproximateFloat64() * unitHourlyCost
+			}
+		}
+		hourlyCosts[container.Name] = hourlyCost
+	}
+	return
+}
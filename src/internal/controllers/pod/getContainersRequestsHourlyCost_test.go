@@ -0,0 +1,53 @@
+// Copyright 2025 The MoneyPod Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pod
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/utils/ptr"
+)
+
+var _ = Describe("getContainersRequestsHourlyCost", func() {
+	It("should price every running container separately", func() {
+		pod := &corev1.Pod{Spec: corev1.PodSpec{
+			InitContainers: []corev1.Container{
+				{Name: "vault-agent-init", Resources: corev1.ResourceRequirements{
+					Requests: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("100m")},
+				}},
+				{Name: "vault-agent", RestartPolicy: ptr.To(corev1.ContainerRestartPolicyAlways),
+					Resources: corev1.ResourceRequirements{
+						Requests: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("200m")},
+					}},
+			},
+			Containers: []corev1.Container{
+				{Name: "app", Resources: corev1.ResourceRequirements{Requests: corev1.ResourceList{
+					corev1.ResourceCPU:    resource.MustParse("1"),
+					corev1.ResourceMemory: resource.MustParse("512Mi"),
+					"nvidia.com/gpu":      resource.MustParse("1"),
+				}}},
+				{Name: "istio-proxy"},
+			},
+		}}
+		hourlyCosts := reconciler.getContainersRequestsHourlyCost(pod, 1, 0.01, map[string]float64{"nvidia.com/gpu": 3})
+		Expect(hourlyCosts).To(HaveLen(3))
+		Expect(hourlyCosts).ToNot(HaveKey("vault-agent-init"))
+		Expect(hourlyCosts["vault-agent"]).To(BeNumerically("~", 0.2, 1e-9))
+		Expect(hourlyCosts["app"]).To(BeNumerically("~", 1+5.12+3, 1e-9))
+		Expect(hourlyCosts["istio-proxy"]).To(BeZero())
+	})
+})
@@ -0,0 +1,39 @@
+// Copyright 2025 The MoneyPod Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pod
+
+import (
+	"context"
+
+	. "github.com/vlasov-y/moneypod/internal/types"
+	corev1 "k8s.io/api/core/v1"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+// getExtendedResourceShares returns the configured shares overridden by the node annotation
+func (r *PodReconciler) getExtendedResourceShares(ctx context.Context, node *corev1.Node) (shares ResourceShares) {
+	log := logf.FromContext(ctx)
+
+	shares = ResourceShares{}
+	for name, share := range r.Options.ExtendedResourceShares {
+		shares[name] = share
+	}
+	if value, exists := node.GetAnnotations()[AnnotationNodeExtendedResourceShares]; exists {
+		if err := shares.Set(value); err != nil {
+			log.Error(err, "failed to parse the node extended resource shares, using the configured ones", "value", value)
+		}
+	}
+	return
+}
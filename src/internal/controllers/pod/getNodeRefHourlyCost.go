@@ -0,0 +1,70 @@
+// Copyright 2025 The MoneyPod Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pod
+
+import (
+	"context"
+
+	corev1 "k8s.io/api/core/v1"
+)
+
+// nodeRefHourlyCost is the node reference prices the pending pods are estimated with
+type nodeRefHourlyCost struct {
+	// Node version the prices are calculated for
+	resourceVersion string
+	// Node cost is known
+	known                   bool
+	cpuCoreHourlyCost       float64
+	memoryMiBHourlyCost     float64
+	extendedUnitHourlyCosts map[string]float64
+}
+
+// getNodeRefHourlyCost returns the node reference prices calculated once per node version,
+// so the node is not priced again for every pending pod
+func (r *PodReconciler) getNodeRefHourlyCost(ctx context.Context, node *corev1.Node) (cost nodeRefHourlyCost) {
+	r.nodeRefHourlyCostsMutex.Lock()
+	defer r.nodeRefHourlyCostsMutex.Unlock()
+	if cached, found := r.nodeRefHourlyCosts[node.Name]; found && cached.resourceVersion == node.ResourceVersion {
+		return cached
+	}
+
+	cost.resourceVersion = node.ResourceVersion
+	if nodeHourlyCost, err := r.getNodeHourlyCost(ctx, node); err == nil && nodeHourlyCost >= 0 {
+		cpuMemoryCostRatio, _ := r.getCPUMemoryCostRatio(ctx, node)
+		cost.cpuCoreHourlyCost, cost.memoryMiBHourlyCost, cost.extendedUnitHourlyCosts = r.getResourcesRefHourlyCost(node,
+			nodeHourlyCost, r.getExtendedResourceShares(ctx, node), cpuMemoryCostRatio)
+		cost.known = true
+	}
+	if r.nodeRefHourlyCosts == nil {
+		r.nodeRefHourlyCosts = map[string]nodeRefHourlyCost{}
+	}
+	r.nodeRefHourlyCosts[node.Name] = cost
+	return
+}
+
+// forgetNodeRefHourlyCosts drops the prices of the nodes not in the list, i.e. deleted
+func (r *PodReconciler) forgetNodeRefHourlyCosts(nodes []corev1.Node) {
+	names := map[string]bool{}
+	for _, node := range nodes {
+		names[node.Name] = true
+	}
+	r.nodeRefHourlyCostsMutex.Lock()
+	defer r.nodeRefHourlyCostsMutex.Unlock()
+	for name := range r.nodeRefHourlyCosts {
+		if !names[name] {
+			delete(r.nodeRefHourlyCosts, name)
+		}
+	}
+}
@@ -0,0 +1,53 @@
+// Copyright 2025 The MoneyPod Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pod
+
+import (
+	"context"
+
+	corev1 "k8s.io/api/core/v1"
+	resourcehelper "k8s.io/component-helpers/resource"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+// indexNodeName is the pod field index by the node name, same as the field selector of the API server,
+// so the clients without the cache list the pods of the node as well
+const indexNodeName = "spec.nodeName"
+
+// getNodeRequests sums up the resources allocated to the pods running on the node
+func (r *PodReconciler) getNodeRequests(ctx context.Context, nodeName string) (requests corev1.ResourceList, err error) {
+	log := logf.FromContext(ctx)
+
+	pods := corev1.PodList{}
+	if err = r.List(ctx, &pods, client.MatchingFields{indexNodeName: nodeName}); err != nil {
+		log.Error(err, "failed to list the node pods", "node", nodeName)
+		return
+	}
+	requests = corev1.ResourceList{}
+	for i := range pods.Items {
+		pod := &pods.Items[i]
+		// Terminated pods do not hold the resources anymore
+		if pod.Status.Phase == corev1.PodSucceeded || pod.Status.Phase == corev1.PodFailed {
+			continue
+		}
+		for name, quantity := range resourcehelper.PodRequests(r.getAllocatedPod(pod), resourcehelper.PodResourcesOptions{}) {
+			sum := requests[name]
+			sum.Add(quantity)
+			requests[name] = sum
+		}
+	}
+	return
+}
@@ -0,0 +1,87 @@
+// Copyright 2025 The MoneyPod Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pod
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+)
+
+// NVIDIA device plugin resources and GPU Feature Discovery labels
+const (
+	resourceNvidiaGPU       = "nvidia.com/gpu"
+	resourceNvidiaGPUShared = "nvidia.com/gpu.shared"
+	labelNvidiaGPUCount     = "nvidia.com/gpu.count"
+	labelNvidiaGPUReplicas  = "nvidia.com/gpu.replicas"
+	labelNvidiaGPUMemory    = "nvidia.com/gpu.memory"
+	labelNvidiaGPUProduct   = "nvidia.com/gpu.product"
+)
+
+// MIG profile resource of the mixed strategy: nvidia.com/mig-1g.10gb
+var migProfileRegexp = regexp.MustCompile(`^nvidia\.com/mig-(\d+)g\.(\d+)gb$`)
+
+// getNvidiaGPUUnitHourlyCosts splits the hourly cost of all node GPUs between the units the pods request.
+// Time-sliced GPU costs the GPU divided by the replicas count, MIG slice costs the average of its compute
+// and memory fractions of the GPU. Single MIG strategy exposes equal slices as nvidia.com/gpu, so it needs nothing special.
+func getNvidiaGPUUnitHourlyCosts(node *corev1.Node, gpusHourlyCost float64) (unitCosts map[string]float64) {
+	unitCosts = map[string]float64{}
+	labels := node.GetLabels()
+
+	replicas, _ := strconv.ParseFloat(labels[labelNvidiaGPUReplicas], 64)
+	replicas = max(replicas, 1)
+	// Compute slices per GPU: 7 on A100 and H100, 4 on A30
+	slices := 7.0
+	if strings.Contains(labels[labelNvidiaGPUProduct], "A30") {
+		slices = 4
+	}
+	memoryMiB, _ := strconv.ParseFloat(labels[labelNvidiaGPUMemory], 64)
+
+	// Part of the physical GPU per unit of the resource
+	fractions := map[string]float64{}
+	for name, quantity := range node.Status.Allocatable {
+		if quantity.IsZero() {
+			continue
+		}
+		if name == resourceNvidiaGPU || name == resourceNvidiaGPUShared {
+			fractions[string(name)] = 1 / replicas
+		} else if match := migProfileRegexp.FindStringSubmatch(string(name)); match != nil {
+			computeSlices, _ := strconv.ParseFloat(match[1], 64)
+			memoryGB, _ := strconv.ParseFloat(match[2], 64)
+			fraction := min(computeSlices/slices, 1)
+			if memoryMiB > 0 {
+				fraction = (fraction + min(memoryGB*1024/memoryMiB, 1)) / 2
+			}
+			fractions[string(name)] = fraction
+		}
+	}
+
+	// Physical GPUs are labeled by GPU Feature Discovery, otherwise they are counted from the units
+	gpus, _ := strconv.ParseFloat(labels[labelNvidiaGPUCount], 64)
+	if gpus <= 0 {
+		for name, fraction := range fractions {
+			gpus += node.Status.Allocatable.Name(corev1.ResourceName(name), "").AsApproximateFloat64() * fraction
+		}
+	}
+	if gpus <= 0 {
+		return
+	}
+	for name, fraction := range fractions {
+		unitCosts[name] = gpusHourlyCost / gpus * fraction
+	}
+	return
+}
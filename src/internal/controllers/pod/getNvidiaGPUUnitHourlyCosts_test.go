@@ -0,0 +1,67 @@
+// Copyright 2025 The MoneyPod Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pod
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = Describe("getNvidiaGPUUnitHourlyCosts", func() {
+	newNode := func(labels map[string]string, allocatable corev1.ResourceList) *corev1.Node {
+		return &corev1.Node{
+			ObjectMeta: metav1.ObjectMeta{Labels: labels},
+			Status:     corev1.NodeStatus{Allocatable: allocatable},
+		}
+	}
+
+	It("should price whole GPUs equally", func() {
+		node := newNode(nil, corev1.ResourceList{resourceNvidiaGPU: resource.MustParse("8")})
+		Expect(getNvidiaGPUUnitHourlyCosts(node, 16)).To(Equal(map[string]float64{resourceNvidiaGPU: 2}))
+	})
+
+	It("should divide the time-sliced GPU by the replicas", func() {
+		node := newNode(map[string]string{
+			labelNvidiaGPUCount:    "2",
+			labelNvidiaGPUReplicas: "4",
+		}, corev1.ResourceList{resourceNvidiaGPU: resource.MustParse("8")})
+		Expect(getNvidiaGPUUnitHourlyCosts(node, 16)).To(HaveKeyWithValue(resourceNvidiaGPU, BeNumerically("~", 2, 1e-9)))
+	})
+
+	It("should price MIG slices by their compute and memory fractions", func() {
+		node := newNode(map[string]string{
+			labelNvidiaGPUCount:   "2",
+			labelNvidiaGPUMemory:  "81920",
+			labelNvidiaGPUProduct: "NVIDIA-H100-80GB-HBM3",
+		}, corev1.ResourceList{
+			resourceNvidiaGPU:        resource.MustParse("1"),
+			"nvidia.com/mig-1g.10gb": resource.MustParse("3"),
+			"nvidia.com/mig-4g.40gb": resource.MustParse("1"),
+		})
+		unitCosts := getNvidiaGPUUnitHourlyCosts(node, 16)
+		Expect(unitCosts).To(HaveLen(3))
+		Expect(unitCosts).To(HaveKeyWithValue(resourceNvidiaGPU, BeNumerically("~", 8, 1e-9)))
+		Expect(unitCosts).To(HaveKeyWithValue("nvidia.com/mig-1g.10gb", BeNumerically("~", 8*(1.0/7+0.125)/2, 1e-9)))
+		Expect(unitCosts).To(HaveKeyWithValue("nvidia.com/mig-4g.40gb", BeNumerically("~", 8*(4.0/7+0.5)/2, 1e-9)))
+	})
+
+	It("should count the GPUs from the units without the labels", func() {
+		node := newNode(nil, corev1.ResourceList{"nvidia.com/mig-1g.5gb": resource.MustParse("7")})
+		Expect(getNvidiaGPUUnitHourlyCosts(node, 7)).To(HaveKeyWithValue("nvidia.com/mig-1g.5gb", BeNumerically("~", 1, 1e-9)))
+	})
+})
@@ -0,0 +1,109 @@
+// Copyright 2025 The MoneyPod Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pod
+
+import (
+	"context"
+	"sort"
+
+	corev1 "k8s.io/api/core/v1"
+	resourcehelper "k8s.io/component-helpers/resource"
+	corev1helper "k8s.io/component-helpers/scheduling/corev1"
+	"k8s.io/component-helpers/scheduling/corev1/nodeaffinity"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+// Estimates of the pending pod cost over the matching nodes
+const (
+	PendingEstimateCheapest = "cheapest"
+	PendingEstimateMedian   = "median"
+)
+
+// getPendingEstimatedHourlyCost prices the pod requests on every priced node it could be scheduled to
+// and has room for, and returns the cheapest or the median of them
+func (r *PodReconciler) getPendingEstimatedHourlyCost(ctx context.Context, pod *corev1.Pod) (hourlyCost float64,
+	found bool, err error) {
+	log := logf.FromContext(ctx)
+
+	nodes := corev1.NodeList{}
+	if err = r.List(ctx, &nodes); err != nil {
+		log.Error(err, "failed to list nodes")
+		return
+	}
+
+	r.forgetNodeRefHourlyCosts(nodes.Items)
+
+	affinity := nodeaffinity.GetRequiredNodeAffinity(pod)
+	requests := resourcehelper.PodRequests(pod, resourcehelper.PodResourcesOptions{})
+	var costs []float64
+	for i := range nodes.Items {
+		node := &nodes.Items[i]
+		if node.Spec.Unschedulable {
+			continue
+		}
+		if matches, _ := affinity.Match(node); !matches {
+			continue
+		}
+		if _, untolerated := corev1helper.FindMatchingUntoleratedTaint(node.Spec.Taints, pod.Spec.Tolerations,
+			func(taint *corev1.Taint) bool {
+				return taint.Effect == corev1.TaintEffectNoSchedule || taint.Effect == corev1.TaintEffectNoExecute
+			}); untolerated {
+			continue
+		}
+		// Nodes with the unknown cost are skipped
+		refHourlyCost := r.getNodeRefHourlyCost(ctx, node)
+		if !refHourlyCost.known {
+			continue
+		}
+		// Node must have room for the pod next to the pods running on it
+		var nodeRequests corev1.ResourceList
+		if nodeRequests, err = r.getNodeRequests(ctx, node.Name); err != nil {
+			return
+		}
+		fits := true
+		for name, quantity := range requests {
+			free := node.Status.Allocatable[name]
+			free.Sub(nodeRequests[name])
+			if quantity.Cmp(free) > 0 {
+				fits = false
+				break
+			}
+		}
+		if !fits {
+			continue
+		}
+		cost, _ := r.getRequestsHourlyCost(ctx, pod, refHourlyCost.cpuCoreHourlyCost, refHourlyCost.memoryMiBHourlyCost,
+			refHourlyCost.extendedUnitHourlyCosts)
+		costs = append(costs, cost)
+	}
+	if len(costs) == 0 {
+		return
+	}
+
+	found = true
+	sort.Float64s(costs)
+	if r.Options.PendingEstimate == PendingEstimateMedian {
+		middle := len(costs) / 2
+		hourlyCost = costs[middle]
+		if len(costs)%2 == 0 {
+			hourlyCost = (costs[middle-1] + costs[middle]) / 2
+		}
+	} else {
+		hourlyCost = costs[0]
+	}
+	log.V(1).Info("pending pod estimated hourly cost", "nodes", len(costs), "estimate", r.Options.PendingEstimate,
+		"cost", hourlyCost)
+	return
+}
@@ -0,0 +1,79 @@
+// Copyright 2025 The MoneyPod Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pod
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/vlasov-y/moneypod/internal/types"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+var _ = Describe("getPendingEstimatedHourlyCost", func() {
+	newNode := func(name string, hourlyCost string) *corev1.Node {
+		return &corev1.Node{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Annotations: map[string]string{AnnotationNodeHourlyCost: hourlyCost}},
+			Status: corev1.NodeStatus{Allocatable: corev1.ResourceList{
+				corev1.ResourceCPU:    resource.MustParse("2"),
+				corev1.ResourceMemory: resource.MustParse("2Gi"),
+			}},
+		}
+	}
+	newPod := func(name string, node string, cpu string, phase corev1.PodPhase) *corev1.Pod {
+		return &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: name},
+			Spec: corev1.PodSpec{NodeName: node, Containers: []corev1.Container{{Name: "app",
+				Resources: corev1.ResourceRequirements{Requests: corev1.ResourceList{
+					corev1.ResourceCPU: resource.MustParse(cpu),
+				}},
+			}}},
+			Status: corev1.PodStatus{Phase: phase},
+		}
+	}
+
+	It("should estimate on the nodes the pod fits only", func() {
+		cheap := newNode("cheap", "2")
+		c := fake.NewClientBuilder().
+			WithObjects(cheap, newNode("pricey", "8"),
+				newPod("full", "cheap", "1500m", corev1.PodRunning),
+				newPod("done", "pricey", "2", corev1.PodSucceeded)).
+			WithIndex(&corev1.Pod{}, indexNodeName, func(obj client.Object) []string {
+				return []string{obj.(*corev1.Pod).Spec.NodeName}
+			}).
+			Build()
+		r := &PodReconciler{
+			Reconciler: Reconciler{Client: c},
+			Options:    PodOptions{PendingEstimate: PendingEstimateCheapest},
+		}
+
+		// 1 of 2 cores and 2 GiB at 8 per hour, the cheap node has no room
+		hourlyCost, found, err := r.getPendingEstimatedHourlyCost(ctx, newPod("pending", "", "1", corev1.PodPending))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(found).To(BeTrue())
+		Expect(hourlyCost).To(BeNumerically("~", 2, 1e-9))
+		Expect(r.nodeRefHourlyCosts).To(HaveLen(2))
+
+		// Prices of the deleted nodes are forgotten
+		Expect(c.Delete(ctx, cheap)).To(Succeed())
+		_, _, err = r.getPendingEstimatedHourlyCost(ctx, newPod("pending", "", "1", corev1.PodPending))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(r.nodeRefHourlyCosts).To(HaveLen(1))
+		Expect(r.nodeRefHourlyCosts).To(HaveKey("pricey"))
+	})
+})
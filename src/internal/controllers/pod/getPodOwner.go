@@ -0,0 +1,52 @@
+// Copyright 2025 The MoneyPod Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pod
+
+import (
+	"context"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+// getPodOwner returns the pod owner, the Deployment for the pods of a ReplicaSet
+func (r *PodReconciler) getPodOwner(ctx context.Context, pod *corev1.Pod) (kind string, name string, err error) {
+	log := logf.FromContext(ctx)
+
+	if len(pod.GetOwnerReferences()) == 0 {
+		return
+	}
+	ownerRef := pod.GetOwnerReferences()[0]
+	kind, name = ownerRef.Kind, ownerRef.Name
+	// Get Deployment name for ReplicaSet
+	if ownerRef.Kind == "ReplicaSet" {
+		replicaset := appsv1.ReplicaSet{}
+		if err = r.Get(ctx, types.NamespacedName{Namespace: pod.Namespace, Name: ownerRef.Name}, &replicaset); err != nil {
+			if !errors.IsNotFound(err) {
+				log.Error(err, "cannot get the replicaset")
+			}
+			return
+		}
+		// Copy ReplicaSet owner to pod info
+		if len(replicaset.GetOwnerReferences()) > 0 {
+			ownerRef = replicaset.GetOwnerReferences()[0]
+			kind, name = ownerRef.Kind, ownerRef.Name
+		}
+	}
+	return
+}
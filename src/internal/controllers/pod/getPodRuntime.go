@@ -0,0 +1,39 @@
+// Copyright 2025 The MoneyPod Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pod
+
+import (
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+)
+
+// getPodRuntime returns the time from the pod start to the last container termination
+func getPodRuntime(pod *corev1.Pod) (runtime time.Duration) {
+	if pod.Status.StartTime == nil {
+		return
+	}
+	startedAt := pod.Status.StartTime.Time
+	finishedAt := startedAt
+	for _, statuses := range [][]corev1.ContainerStatus{pod.Status.InitContainerStatuses, pod.Status.ContainerStatuses} {
+		for _, status := range statuses {
+			if status.State.Terminated != nil && status.State.Terminated.FinishedAt.After(finishedAt) {
+				finishedAt = status.State.Terminated.FinishedAt.Time
+			}
+		}
+	}
+	runtime = finishedAt.Sub(startedAt)
+	return
+}
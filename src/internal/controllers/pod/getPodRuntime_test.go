@@ -0,0 +1,48 @@
+// Copyright 2025 The MoneyPod Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pod
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = Describe("getPodRuntime", func() {
+	startedAt := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	terminated := func(finishedAt time.Time) corev1.ContainerStatus {
+		return corev1.ContainerStatus{State: corev1.ContainerState{
+			Terminated: &corev1.ContainerStateTerminated{FinishedAt: metav1.NewTime(finishedAt)},
+		}}
+	}
+
+	It("should run until the last container finished", func() {
+		pod := &corev1.Pod{Status: corev1.PodStatus{
+			StartTime:             &metav1.Time{Time: startedAt},
+			InitContainerStatuses: []corev1.ContainerStatus{terminated(startedAt.Add(time.Minute))},
+			ContainerStatuses: []corev1.ContainerStatus{
+				terminated(startedAt.Add(90 * time.Minute)), terminated(startedAt.Add(time.Hour)),
+			},
+		}}
+		Expect(getPodRuntime(pod)).To(Equal(90 * time.Minute))
+	})
+
+	It("should not run without the start time", func() {
+		Expect(getPodRuntime(&corev1.Pod{})).To(BeZero())
+	})
+})
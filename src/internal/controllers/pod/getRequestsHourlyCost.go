@@ -19,25 +19,19 @@ import (
 
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
+	resourcehelper "k8s.io/component-helpers/resource"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
 )
 
 func (r *PodReconciler) getRequestsHourlyCost(ctx context.Context, pod *corev1.Pod,
-	cpuCoreHourlyCost float64, memoryMiBHourlyCost float64) (hourlyCost float64) {
+	cpuCoreHourlyCost float64, memoryMiBHourlyCost float64,
+	extendedUnitHourlyCosts map[string]float64) (hourlyCost float64, resourcesHourlyCosts map[string]float64) {
 	log := logf.FromContext(ctx)
 
-	allocatedCPU := resource.Quantity{}
-	allocatedMemory := resource.Quantity{}
-
-	// Sum allocated resources from pod status
-	for _, container := range pod.Spec.Containers {
-		if container.Resources.Requests.Cpu() != nil {
-			allocatedCPU.Add(*container.Resources.Requests.Cpu())
-		}
-		if container.Resources.Requests.Memory() != nil {
-			allocatedMemory.Add(*container.Resources.Requests.Memory())
-		}
-	}
+	// Effective request the scheduler reserves on the node: max(init containers, containers + sidecars) + overhead
+	requests := resourcehelper.PodRequests(pod, resourcehelper.PodResourcesOptions{})
+	allocatedCPU := requests[corev1.ResourceCPU]
+	allocatedMemory := requests[corev1.ResourceMemory]
 
 	// Define base resource units
 	cpuCore := resource.MustParse("1.0")
@@ -49,7 +43,19 @@ func (r *PodReconciler) getRequestsHourlyCost(ctx context.Context, pod *corev1.P
 	cpuCost := allocatedCPU.AsApproximateFloat64() / cpuCoreFloat * cpuCoreHourlyCost
 	memoryCost := allocatedMemory.AsApproximateFloat64() / memoryMiBFloat * memoryMiBHourlyCost
 	hourlyCost = cpuCost + memoryCost
-	log.V(1).Info("pod requests hourly cost", "cpu", cpuCost, "memory", memoryCost, "sum", hourlyCost)
+	resourcesHourlyCosts = map[string]float64{
+		corev1.ResourceCPU.String():    cpuCost,
+		corev1.ResourceMemory.String(): memoryCost,
+	}
+
+	// Extended resources are whole units, e.g. GPUs
+	for name, unitHourlyCost := range extendedUnitHourlyCosts {
+		if quantity, exists := requests[corev1.ResourceName(name)]; exists && !quantity.IsZero() {
+			resourcesHourlyCosts[name] = quantity.AsApproximateFloat64() * unitHourlyCost
+			hourlyCost += resourcesHourlyCosts[name]
+		}
+	}
+	log.V(1).Info("pod requests hourly cost", "resources", resourcesHourlyCosts, "sum", hourlyCost)
 
 	return
 }
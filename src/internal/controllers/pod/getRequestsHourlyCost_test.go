@@ -0,0 +1,76 @@
+// Copyright 2025 The MoneyPod Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pod
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/utils/ptr"
+)
+
+var _ = Describe("getRequestsHourlyCost", func() {
+	container := func(cpu string) corev1.Container {
+		return corev1.Container{Resources: corev1.ResourceRequirements{
+			Requests: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse(cpu)},
+		}}
+	}
+
+	It("should reserve the biggest init container", func() {
+		pod := &corev1.Pod{Spec: corev1.PodSpec{
+			InitContainers: []corev1.Container{container("2")},
+			Containers:     []corev1.Container{container("500m"), container("500m")},
+		}}
+		hourlyCost, _ := reconciler.getRequestsHourlyCost(ctx, pod, 1, 0, nil)
+		Expect(hourlyCost).To(BeNumerically("~", 2, 1e-9))
+	})
+
+	It("should add native sidecars and the overhead", func() {
+		sidecar := container("250m")
+		sidecar.RestartPolicy = ptr.To(corev1.ContainerRestartPolicyAlways)
+		pod := &corev1.Pod{Spec: corev1.PodSpec{
+			InitContainers: []corev1.Container{sidecar},
+			Containers:     []corev1.Container{container("1")},
+			Overhead:       corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("250m")},
+		}}
+		hourlyCost, _ := reconciler.getRequestsHourlyCost(ctx, pod, 1, 0, nil)
+		Expect(hourlyCost).To(BeNumerically("~", 1.5, 1e-9))
+	})
+
+	It("should add the requested extended resources", func() {
+		gpu := container("1")
+		gpu.Resources.Requests["nvidia.com/gpu"] = resource.MustParse("2")
+		pod := &corev1.Pod{Spec: corev1.PodSpec{Containers: []corev1.Container{gpu}}}
+		hourlyCost, resources := reconciler.getRequestsHourlyCost(ctx, pod, 1, 0, map[string]float64{
+			"nvidia.com/gpu": 3, "amd.com/gpu": 5,
+		})
+		Expect(resources).To(Equal(map[string]float64{"cpu": 1, "memory": 0, "nvidia.com/gpu": 6}))
+		Expect(hourlyCost).To(BeNumerically("~", 7, 1e-9))
+	})
+
+	It("should add the ephemeral storage and hugepages", func() {
+		batch := container("1")
+		batch.Resources.Requests[corev1.ResourceEphemeralStorage] = resource.MustParse("10Gi")
+		batch.Resources.Requests["hugepages-2Mi"] = resource.MustParse("1Gi")
+		pod := &corev1.Pod{Spec: corev1.PodSpec{Containers: []corev1.Container{batch}}}
+		hourlyCost, resources := reconciler.getRequestsHourlyCost(ctx, pod, 1, 0, map[string]float64{
+			"ephemeral-storage": 0.1 / (1024 * 1024 * 1024), "hugepages-2Mi": 0.5 / (1024 * 1024 * 1024),
+		})
+		Expect(resources).To(HaveKeyWithValue("ephemeral-storage", BeNumerically("~", 1, 1e-9)))
+		Expect(resources).To(HaveKeyWithValue("hugepages-2Mi", BeNumerically("~", 0.5, 1e-9)))
+		Expect(hourlyCost).To(BeNumerically("~", 2.5, 1e-9))
+	})
+})
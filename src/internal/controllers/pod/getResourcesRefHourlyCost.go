@@ -15,13 +15,72 @@
 package pod
 
 import (
+	"strconv"
+	"strings"
+
+	. "github.com/vlasov-y/moneypod/internal/types"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 )
 
-// getResourcesRefHourlyCost calculates the hourly cost per CPU core and memory MiB for a node
-func (r *PodReconciler) getResourcesRefHourlyCost(node *corev1.Node,
-	nodeHourlyCost float64) (cpuCoreCost float64, memoryMiBCost float64) {
+// Node resources the node cost is divided by
+const (
+	// Reserved resources are spread over the pods
+	PricingBasisAllocatable = "allocatable"
+	// Reserved resources are left out of the pods cost
+	PricingBasisCapacity = "capacity"
+)
+
+// getResourcesRefHourlyCost calculates the hourly cost per CPU core, memory MiB and extended resource unit for a node.
+// CPU core costs as much as cpuMemoryCostRatio GiB of memory.
+// Ephemeral storage and hugepages are priced per byte along with the extended resources.
+// CPU, memory and ephemeral storage are priced per allocatable or capacity unit depending on the pricing basis.
+func (r *PodReconciler) getResourcesRefHourlyCost(node *corev1.Node, nodeHourlyCost float64, shares ResourceShares,
+	cpuMemoryCostRatio float64) (cpuCoreCost float64, memoryMiBCost float64, extendedUnitCosts map[string]float64) {
+	extendedUnitCosts = map[string]float64{}
+
+	resources := node.Status.Allocatable
+	if r.Options.PricingBasis == PricingBasisCapacity && len(node.Status.Capacity) > 0 {
+		resources = node.Status.Capacity
+	}
+
+	// Ephemeral storage takes the node disks cost
+	storageHourlyCost, _ := strconv.ParseFloat(node.GetAnnotations()[AnnotationNodeStorageHourlyCost], 64)
+	storage := resources.StorageEphemeral().AsApproximateFloat64()
+	if storageHourlyCost > 0 && storageHourlyCost < nodeHourlyCost && storage > 0 {
+		extendedUnitCosts[corev1.ResourceEphemeralStorage.String()] = storageHourlyCost / storage
+		nodeHourlyCost -= storageHourlyCost
+	}
+
+	// Extended resources take their share first, the rest is left for CPU and memory.
+	// Shares are scaled down if they sum over the whole node
+	var sharesSum float64
+	// Unit costs of the resources present on the node, as if their share was the whole node cost
+	units := map[string]map[string]float64{}
+	for name, share := range shares {
+		if share <= 0 {
+			continue
+		}
+		if name == resourceNvidiaGPU {
+			// Time-sliced GPUs and MIG slices share the cost of the physical GPUs
+			units[name] = getNvidiaGPUUnitHourlyCosts(node, nodeHourlyCost)
+		} else if allocatable := node.Status.Allocatable.Name(corev1.ResourceName(name), resource.DecimalSI); !allocatable.IsZero() {
+			units[name] = map[string]float64{name: nodeHourlyCost / allocatable.AsApproximateFloat64()}
+		}
+		if len(units[name]) > 0 {
+			sharesSum += share
+		}
+	}
+	scale := 1.0
+	if sharesSum > 1 {
+		scale = 1 / sharesSum
+	}
+	for name, unitCosts := range units {
+		for unit, unitCost := range unitCosts {
+			extendedUnitCosts[unit] = unitCost * shares[name] * scale
+		}
+	}
+	nodeHourlyCost *= 1 - sharesSum*scale
 
 	// Define base resource units
 	cpuCore := resource.MustParse("1.0")
@@ -29,20 +88,34 @@ func (r *PodReconciler) getResourcesRefHourlyCost(node *corev1.Node,
 	cpuCoreFloat := cpuCore.AsApproximateFloat64()
 	memoryGiBFloat := memoryGiB.AsApproximateFloat64()
 
-	// Get node's allocatable resources
-	allocatableCPU := node.Status.Allocatable.Cpu().AsApproximateFloat64()
-	allocatableMemory := node.Status.Allocatable.Memory().AsApproximateFloat64()
+	// Get node's allocatable or capacity resources
+	cpu := resources.Cpu().AsApproximateFloat64()
+	memory := resources.Memory().AsApproximateFloat64()
+	// Hugepages are carved out of the node memory and cost the same.
+	// Memory capacity includes them already, only the allocatable memory has them subtracted
+	var hugePages []string
+	for name, quantity := range node.Status.Allocatable {
+		if strings.HasPrefix(name.String(), corev1.ResourceHugePagesPrefix) && !quantity.IsZero() {
+			if r.Options.PricingBasis != PricingBasisCapacity {
+				memory += quantity.AsApproximateFloat64()
+			}
+			hugePages = append(hugePages, name.String())
+		}
+	}
 
 	// Calculate total resource units and cost per unit
-	// We sum count of Cores and GiBs and divide hourly cost on that value
-	// So for example you have 2.0/8Gi, so there is 2 cores + 8 Gi = 10 units
+	// We count CPU cores as the ratio of GiBs, sum them with GiBs and divide hourly cost on that value
+	// So for example you have 2.0/8Gi and ratio of 1, so there is 2 cores + 8 Gi = 10 units
 	// Hourly price is 0.035, so 1 core == 1 Gi == 0.0035
-	unitsCount := allocatableCPU/cpuCoreFloat + allocatableMemory/memoryGiBFloat
+	unitsCount := cpu/cpuCoreFloat*cpuMemoryCostRatio + memory/memoryGiBFloat
 	unitHourlyCost := nodeHourlyCost / unitsCount
 
 	// Set costs per resource type
-	cpuCoreCost = unitHourlyCost
+	cpuCoreCost = unitHourlyCost * cpuMemoryCostRatio
 	memoryMiBCost = unitHourlyCost / 1024 // Convert from GiB to MiB
+	for _, name := range hugePages {
+		extendedUnitCosts[name] = unitHourlyCost / memoryGiBFloat
+	}
 
 	return
 }
@@ -0,0 +1,118 @@
+// Copyright 2025 The MoneyPod Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pod
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/vlasov-y/moneypod/internal/types"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+var _ = Describe("getResourcesRefHourlyCost", func() {
+	node := &corev1.Node{Status: corev1.NodeStatus{Allocatable: corev1.ResourceList{
+		corev1.ResourceCPU:    resource.MustParse("4"),
+		corev1.ResourceMemory: resource.MustParse("4Gi"),
+		"nvidia.com/gpu":      resource.MustParse("2"),
+	}}}
+
+	It("should split the node cost between CPU and memory", func() {
+		cpuCoreCost, memoryMiBCost, extended := reconciler.getResourcesRefHourlyCost(node, 8, nil, 1)
+		Expect(cpuCoreCost).To(BeNumerically("~", 1, 1e-9))
+		Expect(memoryMiBCost).To(BeNumerically("~", 1.0/1024, 1e-9))
+		Expect(extended).To(BeEmpty())
+	})
+
+	It("should charge CPU cores as the ratio of GiBs", func() {
+		cpuCoreCost, memoryMiBCost, _ := reconciler.getResourcesRefHourlyCost(node, 8, nil, 7)
+		Expect(cpuCoreCost).To(BeNumerically("~", 7*8.0/32, 1e-9))
+		Expect(memoryMiBCost).To(BeNumerically("~", 8.0/32/1024, 1e-9))
+	})
+
+	It("should give the GPUs their share first", func() {
+		cpuCoreCost, _, extended := reconciler.getResourcesRefHourlyCost(node, 8, ResourceShares{
+			"nvidia.com/gpu": 0.75, "amd.com/gpu": 0.5,
+		}, 1)
+		Expect(extended).To(HaveLen(1))
+		Expect(extended).To(HaveKeyWithValue("nvidia.com/gpu", BeNumerically("~", 3, 1e-9)))
+		Expect(cpuCoreCost).To(BeNumerically("~", 0.25, 1e-9))
+	})
+
+	It("should scale the shares down to the node cost", func() {
+		cpuCoreCost, _, extended := reconciler.getResourcesRefHourlyCost(node, 8, ResourceShares{"nvidia.com/gpu": 1}, 1)
+		Expect(extended).To(HaveKeyWithValue("nvidia.com/gpu", BeNumerically("~", 4, 1e-9)))
+		Expect(cpuCoreCost).To(BeZero())
+	})
+
+	It("should give the MIG slices the GPU share", func() {
+		mig := &corev1.Node{Status: corev1.NodeStatus{Allocatable: corev1.ResourceList{
+			corev1.ResourceCPU:      resource.MustParse("4"),
+			corev1.ResourceMemory:   resource.MustParse("4Gi"),
+			"nvidia.com/mig-1g.5gb": resource.MustParse("7"),
+		}}}
+		cpuCoreCost, _, extended := reconciler.getResourcesRefHourlyCost(mig, 8, ResourceShares{"nvidia.com/gpu": 0.875}, 1)
+		Expect(extended).To(HaveKeyWithValue("nvidia.com/mig-1g.5gb", BeNumerically("~", 1, 1e-9)))
+		Expect(cpuCoreCost).To(BeNumerically("~", 0.125, 1e-9))
+	})
+
+	It("should price the ephemeral storage from the node disks cost", func() {
+		disks := node.DeepCopy()
+		disks.Annotations = map[string]string{AnnotationNodeStorageHourlyCost: "2"}
+		disks.Status.Allocatable[corev1.ResourceEphemeralStorage] = resource.MustParse("100Gi")
+		cpuCoreCost, _, extended := reconciler.getResourcesRefHourlyCost(disks, 10, nil, 1)
+		Expect(extended).To(HaveKeyWithValue("ephemeral-storage",
+			BeNumerically("~", 2/(100*1024*1024*1024.0), 1e-18)))
+		Expect(cpuCoreCost).To(BeNumerically("~", 1, 1e-9))
+	})
+
+	It("should price the hugepages as memory", func() {
+		hugePages := node.DeepCopy()
+		hugePages.Status.Allocatable["hugepages-2Mi"] = resource.MustParse("4Gi")
+		cpuCoreCost, memoryMiBCost, extended := reconciler.getResourcesRefHourlyCost(hugePages, 12, nil, 1)
+		Expect(cpuCoreCost).To(BeNumerically("~", 1, 1e-9))
+		Expect(extended).To(HaveKeyWithValue("hugepages-2Mi", BeNumerically("~", memoryMiBCost/(1024*1024), 1e-18)))
+	})
+
+	It("should price per capacity unit with the capacity basis", func() {
+		reserved := node.DeepCopy()
+		reserved.Status.Capacity = corev1.ResourceList{
+			corev1.ResourceCPU:    resource.MustParse("8"),
+			corev1.ResourceMemory: resource.MustParse("8Gi"),
+		}
+		r := &PodReconciler{Options: PodOptions{PricingBasis: PricingBasisCapacity}}
+		cpuCoreCost, memoryMiBCost, _ := r.getResourcesRefHourlyCost(reserved, 8, nil, 1)
+		Expect(cpuCoreCost).To(BeNumerically("~", 0.5, 1e-9))
+		Expect(memoryMiBCost).To(BeNumerically("~", 0.5/1024, 1e-9))
+
+		cpuCoreCost, _, _ = reconciler.getResourcesRefHourlyCost(reserved, 8, nil, 1)
+		Expect(cpuCoreCost).To(BeNumerically("~", 1, 1e-9))
+	})
+
+	It("should not count the hugepages twice with the capacity basis", func() {
+		hugePages := node.DeepCopy()
+		hugePages.Status.Allocatable[corev1.ResourceMemory] = resource.MustParse("3Gi")
+		hugePages.Status.Allocatable["hugepages-2Mi"] = resource.MustParse("4Gi")
+		hugePages.Status.Capacity = corev1.ResourceList{
+			corev1.ResourceCPU:    resource.MustParse("4"),
+			corev1.ResourceMemory: resource.MustParse("8Gi"),
+			"hugepages-2Mi":       resource.MustParse("4Gi"),
+		}
+		r := &PodReconciler{Options: PodOptions{PricingBasis: PricingBasisCapacity}}
+		cpuCoreCost, memoryMiBCost, extended := r.getResourcesRefHourlyCost(hugePages, 12, nil, 1)
+		Expect(cpuCoreCost).To(BeNumerically("~", 1, 1e-9))
+		Expect(extended).To(HaveKeyWithValue("hugepages-2Mi", BeNumerically("~", memoryMiBCost/(1024*1024), 1e-18)))
+	})
+})
@@ -0,0 +1,71 @@
+// Copyright 2025 The MoneyPod Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pod
+
+import (
+	"context"
+
+	"github.com/vlasov-y/moneypod/internal/cluster"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+// getTerminatedPodCost prices the requests of the terminated pod never priced while running with its node price,
+// e.g. the pod finished before the first reconcile or while the operator was down
+func (r *PodReconciler) getTerminatedPodCost(ctx context.Context, pod *corev1.Pod) (cost cluster.PodCost, found bool,
+	err error) {
+	log := logf.FromContext(ctx)
+
+	// Pod has never run
+	if pod.Spec.NodeName == "" || pod.Status.StartTime == nil {
+		return
+	}
+
+	node := corev1.Node{}
+	if err = r.Get(ctx, types.NamespacedName{Name: pod.Spec.NodeName}, &node); err != nil {
+		if !errors.IsNotFound(err) {
+			log.Error(err, "cannot get the node")
+			return
+		}
+		log.Info("node of the terminated pod is gone, its final cost is unknown", "node", pod.Spec.NodeName)
+		return cost, false, nil
+	}
+	var nodeHourlyCost float64
+	if nodeHourlyCost, err = r.getNodeHourlyCost(ctx, &node); err != nil || nodeHourlyCost < 0 {
+		return
+	}
+
+	// Same prices as for the running pod
+	cpuMemoryCostRatio, _ := r.getCPUMemoryCostRatio(ctx, &node)
+	cpuCoreHourlyCost, memoryMiBHourlyCost, extendedUnitHourlyCosts := r.getResourcesRefHourlyCost(&node,
+		nodeHourlyCost, r.getExtendedResourceShares(ctx, &node), cpuMemoryCostRatio)
+	var requestsPod *corev1.Pod
+	if requestsPod, _, err = r.imputeRequests(ctx, r.getAllocatedPod(pod)); err != nil {
+		return
+	}
+	cost.RequestsHourlyCost, _ = r.getRequestsHourlyCost(ctx, requestsPod, cpuCoreHourlyCost, memoryMiBHourlyCost,
+		extendedUnitHourlyCosts)
+
+	// Owner of the gone ReplicaSet is not known, the cost is recorded on the pod anyway
+	if cost.OwnerKind, cost.OwnerName, err = r.getPodOwner(ctx, pod); err != nil && !errors.IsNotFound(err) {
+		return
+	}
+	err = nil
+	cost.Namespace, cost.Node = pod.Namespace, pod.Spec.NodeName
+	found = true
+	return
+}
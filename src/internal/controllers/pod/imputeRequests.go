@@ -0,0 +1,105 @@
+// Copyright 2025 The MoneyPod Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pod
+
+import (
+	"context"
+
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+// Policies for the containers without CPU or memory requests
+const (
+	// Missing requests cost nothing
+	RequestsPolicyNone = "none"
+	// Container limits are charged instead
+	RequestsPolicyLimits = "limits"
+	// Namespace LimitRange default requests are charged
+	RequestsPolicyLimitRange = "limitrange"
+	// Configured minimum requests are charged
+	RequestsPolicyMinimum = "minimum"
+	// Label value of the pods with all requests set
+	requestsPolicyRequests = "requests"
+)
+
+// imputeRequests returns the copy of the pod with the missing CPU and memory requests filled by the policy
+// and the policy that applied: "requests" if nothing was missing and "none" if nothing was imputed
+func (r *PodReconciler) imputeRequests(ctx context.Context, pod *corev1.Pod) (imputed *corev1.Pod, policy string, err error) {
+	log := logf.FromContext(ctx)
+	imputed = pod.DeepCopy()
+	policy = requestsPolicyRequests
+	var missing bool
+
+	// Defaults for the missing requests per container, nil are filled from the limits
+	var defaults corev1.ResourceList
+	switch r.Options.RequestsPolicy {
+	case RequestsPolicyMinimum:
+		defaults = r.minimumRequests
+	case RequestsPolicyLimitRange:
+		limitRanges := corev1.LimitRangeList{}
+		if err = r.List(ctx, &limitRanges, client.InNamespace(pod.Namespace)); err != nil {
+			log.Error(err, "failed to list limit ranges")
+			return
+		}
+		defaults = corev1.ResourceList{}
+		for _, limitRange := range limitRanges.Items {
+			for _, item := range limitRange.Spec.Limits {
+				if item.Type != corev1.LimitTypeContainer {
+					continue
+				}
+				// Like the admission, the first item setting a value wins and
+				// default limits are the default requests if the latter are not set
+				for _, values := range []corev1.ResourceList{item.DefaultRequest, item.Default} {
+					for name, quantity := range values {
+						if _, exists := defaults[name]; !exists {
+							defaults[name] = quantity
+						}
+					}
+				}
+			}
+		}
+	}
+
+	for _, containers := range [][]corev1.Container{imputed.Spec.InitContainers, imputed.Spec.Containers} {
+		for i := range containers {
+			container := &containers[i]
+			for _, name := range []corev1.ResourceName{corev1.ResourceCPU, corev1.ResourceMemory} {
+				if _, exists := container.Resources.Requests[name]; exists {
+					continue
+				}
+				missing = true
+				value, exists := defaults[name]
+				if r.Options.RequestsPolicy == RequestsPolicyLimits {
+					value, exists = container.Resources.Limits[name]
+				}
+				if !exists {
+					continue
+				}
+				if container.Resources.Requests == nil {
+					container.Resources.Requests = corev1.ResourceList{}
+				}
+				container.Resources.Requests[name] = value
+				policy = r.Options.RequestsPolicy
+			}
+		}
+	}
+	// Missing requests are left free if the policy has no values for them
+	if missing && policy == requestsPolicyRequests {
+		policy = RequestsPolicyNone
+	}
+	return
+}
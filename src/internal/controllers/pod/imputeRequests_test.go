@@ -0,0 +1,131 @@
+// Copyright 2025 The MoneyPod Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pod
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/vlasov-y/moneypod/internal/types"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+var _ = Describe("imputeRequests", func() {
+	bestEffort := func() *corev1.Pod {
+		return &corev1.Pod{Spec: corev1.PodSpec{Containers: []corev1.Container{{
+			Resources: corev1.ResourceRequirements{
+				Limits: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("2")},
+			},
+		}}}}
+	}
+
+	It("should keep the pod with all requests set", func() {
+		pod := &corev1.Pod{Spec: corev1.PodSpec{Containers: []corev1.Container{{
+			Resources: corev1.ResourceRequirements{Requests: corev1.ResourceList{
+				corev1.ResourceCPU:    resource.MustParse("1"),
+				corev1.ResourceMemory: resource.MustParse("1Gi"),
+			}},
+		}}}}
+		r := &PodReconciler{Options: PodOptions{RequestsPolicy: RequestsPolicyMinimum}}
+		imputed, policy, err := r.imputeRequests(ctx, pod)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(policy).To(Equal("requests"))
+		Expect(imputed.Spec).To(Equal(pod.Spec))
+	})
+
+	It("should not charge the missing requests with the none policy", func() {
+		r := &PodReconciler{Options: PodOptions{RequestsPolicy: RequestsPolicyNone}}
+		imputed, policy, err := r.imputeRequests(ctx, bestEffort())
+		Expect(err).NotTo(HaveOccurred())
+		Expect(policy).To(Equal(RequestsPolicyNone))
+		Expect(imputed.Spec.Containers[0].Resources.Requests).To(BeEmpty())
+	})
+
+	It("should charge the limits with the limits policy", func() {
+		r := &PodReconciler{Options: PodOptions{RequestsPolicy: RequestsPolicyLimits}}
+		pod := bestEffort()
+		imputed, policy, err := r.imputeRequests(ctx, pod)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(policy).To(Equal(RequestsPolicyLimits))
+		Expect(imputed.Spec.Containers[0].Resources.Requests).To(HaveLen(1))
+		Expect(imputed.Spec.Containers[0].Resources.Requests.Cpu().String()).To(Equal("2"))
+		// Original pod is not modified
+		Expect(pod.Spec.Containers[0].Resources.Requests).To(BeNil())
+	})
+
+	It("should charge the minimum with the minimum policy", func() {
+		r := &PodReconciler{
+			Options: PodOptions{RequestsPolicy: RequestsPolicyMinimum},
+			minimumRequests: corev1.ResourceList{
+				corev1.ResourceCPU:    resource.MustParse("10m"),
+				corev1.ResourceMemory: resource.MustParse("32Mi"),
+			},
+		}
+		imputed, policy, err := r.imputeRequests(ctx, bestEffort())
+		Expect(err).NotTo(HaveOccurred())
+		Expect(policy).To(Equal(RequestsPolicyMinimum))
+		Expect(imputed.Spec.Containers[0].Resources.Requests.Cpu().String()).To(Equal("10m"))
+		Expect(imputed.Spec.Containers[0].Resources.Requests.Memory().String()).To(Equal("32Mi"))
+	})
+
+	It("should not label the policy if it has no values for the missing requests", func() {
+		r := &PodReconciler{Options: PodOptions{RequestsPolicy: RequestsPolicyLimits}}
+		imputed, policy, err := r.imputeRequests(ctx, &corev1.Pod{Spec: corev1.PodSpec{
+			Containers: []corev1.Container{{}},
+		}})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(policy).To(Equal(RequestsPolicyNone))
+		Expect(imputed.Spec.Containers[0].Resources.Requests).To(BeEmpty())
+	})
+
+	It("should charge the first LimitRange defaults like the admission", func() {
+		newLimitRange := func(name string, item corev1.LimitRangeItem) *corev1.LimitRange {
+			item.Type = corev1.LimitTypeContainer
+			return &corev1.LimitRange{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: name},
+				Spec:       corev1.LimitRangeSpec{Limits: []corev1.LimitRangeItem{item}},
+			}
+		}
+		r := &PodReconciler{
+			Reconciler: Reconciler{Client: fake.NewClientBuilder().WithObjects(
+				newLimitRange("a", corev1.LimitRangeItem{
+					Default: corev1.ResourceList{
+						corev1.ResourceCPU:    resource.MustParse("1"),
+						corev1.ResourceMemory: resource.MustParse("1Gi"),
+					},
+					DefaultRequest: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("100m")},
+				}),
+				newLimitRange("b", corev1.LimitRangeItem{
+					DefaultRequest: corev1.ResourceList{
+						corev1.ResourceCPU:    resource.MustParse("200m"),
+						corev1.ResourceMemory: resource.MustParse("256Mi"),
+					},
+				}),
+			).Build()},
+			Options: PodOptions{RequestsPolicy: RequestsPolicyLimitRange},
+		}
+		imputed, policy, err := r.imputeRequests(ctx, &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "default"},
+			Spec:       corev1.PodSpec{Containers: []corev1.Container{{}}},
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(policy).To(Equal(RequestsPolicyLimitRange))
+		// Default request wins over the default limit, the first LimitRange over the second one
+		Expect(imputed.Spec.Containers[0].Resources.Requests.Cpu().String()).To(Equal("100m"))
+		Expect(imputed.Spec.Containers[0].Resources.Requests.Memory().String()).To(Equal("1Gi"))
+	})
+})
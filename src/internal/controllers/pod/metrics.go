@@ -15,10 +15,14 @@
 package pod
 
 import (
+	"strings"
+
 	"github.com/prometheus/client_golang/prometheus"
+	"github.com/vlasov-y/moneypod/internal/cluster"
 	"github.com/vlasov-y/moneypod/internal/monitoring"
 	"github.com/vlasov-y/moneypod/internal/types"
 	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
 func deletePodMetrics(pod *corev1.Pod) {
@@ -31,17 +35,54 @@ func deletePodMetrics(pod *corev1.Pod) {
 	monitoring.PodRequestsHourlyCostMetric.DeletePartialMatch(prometheus.Labels{
 		"name": pod.Name, "namespace": pod.Namespace,
 	})
+	monitoring.PodGPUHourlyCostMetric.DeletePartialMatch(prometheus.Labels{
+		"name": pod.Name, "namespace": pod.Namespace,
+	})
+	monitoring.ContainerRequestsHourlyCostMetric.DeletePartialMatch(prometheus.Labels{
+		"name": pod.Name, "namespace": pod.Namespace,
+	})
+	monitoring.PodPendingEstimatedHourlyCostMetric.DeletePartialMatch(prometheus.Labels{
+		"name": pod.Name, "namespace": pod.Namespace,
+	})
+}
+
+func createPendingPodMetrics(pod *corev1.Pod, info *types.PodInfo, estimate string) {
+	deletePodMetrics(pod)
+	monitoring.PodPendingEstimatedHourlyCostMetric.WithLabelValues(
+		pod.Name, pod.Name, pod.Namespace, info.Owner.Kind, info.Owner.Name, estimate,
+	).Set(info.PodPendingEstimatedHourlyCost)
 }
 
 func createPodMetrics(pod *corev1.Pod, info *types.PodInfo) {
 	deletePodMetrics(pod)
 	monitoring.PodCPUHourlyCostMetric.WithLabelValues(
-		pod.Name, pod.Name, pod.Namespace, info.Owner.Kind, info.Owner.Name, pod.Spec.NodeName,
+		pod.Name, pod.Name, pod.Namespace, info.Owner.Kind, info.Owner.Name, pod.Spec.NodeName, info.SplitStrategy,
 	).Set(info.NodeCPUCoreHourlyCost)
 	monitoring.PodMemoryHourlyCostMetric.WithLabelValues(
-		pod.Name, pod.Name, pod.Namespace, info.Owner.Kind, info.Owner.Name, pod.Spec.NodeName,
+		pod.Name, pod.Name, pod.Namespace, info.Owner.Kind, info.Owner.Name, pod.Spec.NodeName, info.SplitStrategy,
 	).Set(info.NodeMemoryMiBHourlyCost)
 	monitoring.PodRequestsHourlyCostMetric.WithLabelValues(
-		pod.Name, pod.Name, pod.Namespace, info.Owner.Kind, info.Owner.Name, pod.Spec.NodeName,
+		pod.Name, pod.Name, pod.Namespace, info.Owner.Kind, info.Owner.Name, pod.Spec.NodeName, info.SplitStrategy,
+		info.RequestsPolicy,
 	).Set(info.PodRequestsHourlyCost)
+	for resource, hourlyCost := range info.PodResourcesHourlyCosts {
+		// Native resources (cpu, memory, ephemeral-storage, hugepages) have no domain and are not extended
+		if !strings.Contains(resource, "/") {
+			continue
+		}
+		monitoring.PodGPUHourlyCostMetric.WithLabelValues(
+			pod.Name, pod.Name, pod.Namespace, info.Owner.Kind, info.Owner.Name, pod.Spec.NodeName, resource,
+		).Set(hourlyCost)
+	}
+	// Usage job raises the effective cost from the usage, the requests are charged until the pod is sampled
+	if !cluster.HasPodUsage(client.ObjectKeyFromObject(pod)) {
+		monitoring.PodEffectiveHourlyCostMetric.WithLabelValues(
+			pod.Name, pod.Name, pod.Namespace, info.Owner.Kind, info.Owner.Name, pod.Spec.NodeName,
+		).Set(info.PodRequestsHourlyCost)
+	}
+	for container, hourlyCost := range info.ContainersRequestsHourlyCosts {
+		monitoring.ContainerRequestsHourlyCostMetric.WithLabelValues(
+			pod.Name, pod.Name, pod.Namespace, info.Owner.Kind, info.Owner.Name, pod.Spec.NodeName, container,
+		).Set(hourlyCost)
+	}
 }
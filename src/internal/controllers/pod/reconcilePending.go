@@ -0,0 +1,47 @@
+// Copyright 2025 The MoneyPod Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pod
+
+import (
+	"context"
+
+	. "github.com/vlasov-y/moneypod/internal/types"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// reconcilePending exports the estimated cost of the pod waiting for a node
+func (r *PodReconciler) reconcilePending(ctx context.Context, pod *corev1.Pod) (err error) {
+	var info PodInfo
+	if info.Owner.Kind, info.Owner.Name, err = r.getPodOwner(ctx, pod); err != nil {
+		return client.IgnoreNotFound(err)
+	}
+
+	var requestsPod *corev1.Pod
+	if requestsPod, _, err = r.imputeRequests(ctx, pod); err != nil {
+		return
+	}
+	var found bool
+	if info.PodPendingEstimatedHourlyCost, found, err = r.getPendingEstimatedHourlyCost(ctx, requestsPod); err != nil {
+		return
+	}
+	// No node the pod fits is priced yet
+	if !found {
+		deletePodMetrics(pod)
+		return
+	}
+	createPendingPodMetrics(pod, &info, r.Options.PendingEstimate)
+	return
+}
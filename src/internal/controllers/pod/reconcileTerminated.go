@@ -0,0 +1,60 @@
+// Copyright 2025 The MoneyPod Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pod
+
+import (
+	"context"
+
+	"github.com/vlasov-y/moneypod/internal/cluster"
+	. "github.com/vlasov-y/moneypod/internal/types"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+// reconcileTerminated stops charging the pod and records its final cost from the cost it was last priced with,
+// so the cost is kept even if its node is gone or not priced anymore.
+// Pods never priced while running are priced with their node price.
+func (r *PodReconciler) reconcileTerminated(ctx context.Context, pod *corev1.Pod) (err error) {
+	log := logf.FromContext(ctx)
+	key := client.ObjectKeyFromObject(pod)
+	deletePodMetrics(pod)
+
+	// Final cost is recorded once
+	if _, exists := pod.GetAnnotations()[AnnotationFinalCost]; exists {
+		r.deletePodCost(ctx, key)
+		return
+	}
+	cost, found := cluster.GetPodCost(key)
+	if !found {
+		if cost, found, err = r.getTerminatedPodCost(ctx, pod); err != nil {
+			return
+		}
+		if !found {
+			r.deletePodCost(ctx, key)
+			return
+		}
+	}
+	// Stored cost is kept until the pod is annotated, so the failed attempt is retried
+	if err = r.annotateFinalCost(ctx, pod, cost); err != nil {
+		if errors.IsConflict(err) {
+			log.V(1).Info("pod changed since it was read, retrying")
+		}
+		return
+	}
+	r.deletePodCost(ctx, key)
+	return
+}
@@ -0,0 +1,117 @@
+// Copyright 2025 The MoneyPod Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pod
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/vlasov-y/moneypod/internal/cluster"
+	. "github.com/vlasov-y/moneypod/internal/types"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+var _ = Describe("reconcileTerminated", func() {
+	key := types.NamespacedName{Namespace: "default", Name: "job-terminated"}
+	start := time.Now().Add(-2 * time.Hour).Truncate(time.Second)
+
+	newPod := func() *corev1.Pod {
+		return &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Namespace: key.Namespace, Name: key.Name},
+			Spec:       corev1.PodSpec{NodeName: "gone"},
+			Status: corev1.PodStatus{
+				Phase:     corev1.PodSucceeded,
+				StartTime: &metav1.Time{Time: start},
+				ContainerStatuses: []corev1.ContainerStatus{{State: corev1.ContainerState{
+					Terminated: &corev1.ContainerStateTerminated{FinishedAt: metav1.NewTime(start.Add(time.Hour))},
+				}}},
+			},
+		}
+	}
+
+	AfterEach(func() {
+		cluster.DeletePodCost(key)
+	})
+
+	It("should record the final cost once from the stored cost", func() {
+		job := &batchv1.Job{ObjectMeta: metav1.ObjectMeta{Namespace: key.Namespace, Name: "job"}}
+		r := &PodReconciler{Reconciler: Reconciler{
+			Client:   fake.NewClientBuilder().WithObjects(newPod(), job).Build(),
+			Recorder: record.NewFakeRecorder(100),
+		}}
+		cost := cluster.PodCost{Namespace: key.Namespace, Node: "gone", OwnerKind: "Job", OwnerName: "job", RequestsHourlyCost: 2}
+		cluster.SetPodCost(key, cost)
+
+		// Node is gone, the stored cost is used
+		stale := &corev1.Pod{}
+		Expect(r.Get(ctx, key, stale)).To(Succeed())
+		pod := stale.DeepCopy()
+		Expect(r.reconcileTerminated(ctx, pod)).To(Succeed())
+		Expect(pod.Annotations).To(HaveKeyWithValue(AnnotationFinalCost, "2.0000000000"))
+		_, found := cluster.GetPodCost(key)
+		Expect(found).To(BeFalse())
+
+		// Reconcile queued before the annotation reached the cache adds nothing
+		Expect(r.reconcileTerminated(ctx, stale.DeepCopy())).To(Succeed())
+		// Stale pod is not patched even if the cost is known again
+		cluster.SetPodCost(key, cost)
+		Expect(r.reconcileTerminated(ctx, stale.DeepCopy())).ToNot(Succeed())
+
+		Expect(r.Get(ctx, client.ObjectKeyFromObject(job), job)).To(Succeed())
+		Expect(job.Annotations).To(HaveKeyWithValue(AnnotationFinalCost, "2.0000000000"))
+	})
+
+	It("should price the pod never priced while running with its node price", func() {
+		node := &corev1.Node{
+			ObjectMeta: metav1.ObjectMeta{Name: "node-1", Annotations: map[string]string{AnnotationNodeHourlyCost: "4"}},
+			Status: corev1.NodeStatus{Allocatable: corev1.ResourceList{
+				corev1.ResourceCPU:    resource.MustParse("2"),
+				corev1.ResourceMemory: resource.MustParse("2Gi"),
+			}},
+		}
+		pod := newPod()
+		pod.Spec.NodeName = node.Name
+		pod.Spec.Containers = []corev1.Container{{Name: "app", Resources: corev1.ResourceRequirements{
+			Requests: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("1")},
+		}}}
+		r := &PodReconciler{Reconciler: Reconciler{
+			Client:   fake.NewClientBuilder().WithObjects(pod, node).Build(),
+			Recorder: record.NewFakeRecorder(100),
+		}}
+		Expect(r.Get(ctx, key, pod)).To(Succeed())
+		Expect(r.reconcileTerminated(ctx, pod)).To(Succeed())
+		// 1 of 2 cores and 2 GiB at 4 per hour for an hour
+		Expect(pod.Annotations).To(HaveKeyWithValue(AnnotationFinalCost, "1.0000000000"))
+	})
+
+	It("should skip the pods never priced if their node is gone", func() {
+		r := &PodReconciler{Reconciler: Reconciler{
+			Client:   fake.NewClientBuilder().WithObjects(newPod()).Build(),
+			Recorder: record.NewFakeRecorder(100),
+		}}
+		pod := newPod()
+		Expect(r.Get(ctx, key, pod)).To(Succeed())
+		Expect(r.reconcileTerminated(ctx, pod)).To(Succeed())
+		Expect(pod.Annotations).ToNot(HaveKey(AnnotationFinalCost))
+	})
+})
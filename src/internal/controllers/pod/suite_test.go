@@ -54,6 +54,7 @@ var _ = BeforeSuite(func() {
 			Scheme:   suite.Client.Scheme(),
 			Recorder: suite.Recorder,
 		},
+		Options: PodOptions{PendingEstimate: PendingEstimateCheapest},
 	}
 })
 
@@ -0,0 +1,69 @@
+// Copyright 2025 The MoneyPod Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pod
+
+import (
+	"context"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/vlasov-y/moneypod/internal/monitoring"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/types"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+// updateNodeIdleHourlyCost exports the cost of the node CPU and memory not requested by its pods.
+// Pods are listed with the node name index and priced with the node price annotations.
+// Failures are only logged, since the pod cost is known anyway.
+func (r *PodReconciler) updateNodeIdleHourlyCost(ctx context.Context, nodeName string) {
+	log := logf.FromContext(ctx).WithValues("node", nodeName)
+
+	node := corev1.Node{}
+	if err := r.Get(ctx, types.NamespacedName{Name: nodeName}, &node); err != nil {
+		if errors.IsNotFound(err) {
+			monitoring.NodeIdleHourlyCostMetric.DeletePartialMatch(prometheus.Labels{"name": nodeName})
+		} else {
+			log.Error(err, "cannot get the node")
+		}
+		return
+	}
+	refHourlyCost := r.getNodeRefHourlyCost(ctx, &node)
+	if !refHourlyCost.known {
+		return
+	}
+	requests, err := r.getNodeRequests(ctx, nodeName)
+	if err != nil {
+		return
+	}
+
+	// Define base resource units
+	cpuCore := resource.MustParse("1.0")
+	memoryMiB := resource.MustParse("1Mi")
+	cpuCoreFloat := cpuCore.AsApproximateFloat64()
+	memoryMiBFloat := memoryMiB.AsApproximateFloat64()
+
+	idleCPU := node.Status.Allocatable.Cpu().AsApproximateFloat64() - requests.Cpu().AsApproximateFloat64()
+	idleMemory := node.Status.Allocatable.Memory().AsApproximateFloat64() - requests.Memory().AsApproximateFloat64()
+	cpuHourlyCost := max(idleCPU/cpuCoreFloat*refHourlyCost.cpuCoreHourlyCost, 0)
+	memoryHourlyCost := max(idleMemory/memoryMiBFloat*refHourlyCost.memoryMiBHourlyCost, 0)
+
+	monitoring.NodeIdleHourlyCostMetric.WithLabelValues(nodeName, nodeName, corev1.ResourceCPU.String()).
+		Set(cpuHourlyCost)
+	monitoring.NodeIdleHourlyCostMetric.WithLabelValues(nodeName, nodeName, corev1.ResourceMemory.String()).
+		Set(memoryHourlyCost)
+	log.V(1).Info("node idle hourly cost", "cpu", cpuHourlyCost, "memory", memoryHourlyCost)
+}
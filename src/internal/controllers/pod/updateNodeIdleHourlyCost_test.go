@@ -0,0 +1,70 @@
+// Copyright 2025 The MoneyPod Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pod
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/vlasov-y/moneypod/internal/monitoring"
+	. "github.com/vlasov-y/moneypod/internal/types"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+var _ = Describe("updateNodeIdleHourlyCost", func() {
+	newPod := func(name string, node string, cpu string, memory string, phase corev1.PodPhase) *corev1.Pod {
+		return &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: name},
+			Spec: corev1.PodSpec{NodeName: node, Containers: []corev1.Container{{Name: "app",
+				Resources: corev1.ResourceRequirements{Requests: corev1.ResourceList{
+					corev1.ResourceCPU:    resource.MustParse(cpu),
+					corev1.ResourceMemory: resource.MustParse(memory),
+				}},
+			}}},
+			Status: corev1.PodStatus{Phase: phase},
+		}
+	}
+
+	It("should charge the node resources not requested by the pods on it", func() {
+		node := &corev1.Node{
+			ObjectMeta: metav1.ObjectMeta{Name: "idle", Annotations: map[string]string{AnnotationNodeHourlyCost: "8"}},
+			Status: corev1.NodeStatus{Allocatable: corev1.ResourceList{
+				corev1.ResourceCPU:    resource.MustParse("4"),
+				corev1.ResourceMemory: resource.MustParse("4Gi"),
+			}},
+		}
+		c := fake.NewClientBuilder().
+			WithObjects(node,
+				newPod("idle-a", "idle", "1", "512Mi", corev1.PodRunning),
+				newPod("idle-b", "other", "1", "1Gi", corev1.PodRunning),
+				newPod("idle-c", "idle", "2", "2Gi", corev1.PodSucceeded)).
+			WithIndex(&corev1.Pod{}, indexNodeName, func(obj client.Object) []string {
+				return []string{obj.(*corev1.Pod).Spec.NodeName}
+			}).
+			Build()
+		r := &PodReconciler{Reconciler: Reconciler{Client: c}}
+
+		// 1 per core and per GiB
+		r.updateNodeIdleHourlyCost(ctx, "idle")
+		Expect(testutil.ToFloat64(monitoring.NodeIdleHourlyCostMetric.WithLabelValues("idle", "idle", "cpu"))).
+			To(BeNumerically("~", 3, 1e-9))
+		Expect(testutil.ToFloat64(monitoring.NodeIdleHourlyCostMetric.WithLabelValues("idle", "idle", "memory"))).
+			To(BeNumerically("~", 3.5, 1e-9))
+	})
+})
@@ -0,0 +1,64 @@
+// Copyright 2025 The MoneyPod Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pod
+
+import (
+	"strings"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/vlasov-y/moneypod/internal/monitoring"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+// updateNodeReservedHourlyCost exports the cost of the node capacity reserved for the system and the kubelet.
+// Reserved resources are only left out of the pods cost with the capacity pricing basis.
+func (r *PodReconciler) updateNodeReservedHourlyCost(node *corev1.Node, cpuCoreHourlyCost float64,
+	memoryMiBHourlyCost float64, extendedUnitHourlyCosts map[string]float64) {
+	if r.Options.PricingBasis != PricingBasisCapacity {
+		monitoring.NodeReservedHourlyCostMetric.DeletePartialMatch(prometheus.Labels{"name": node.Name})
+		return
+	}
+
+	// Define base resource units
+	cpuCore := resource.MustParse("1.0")
+	memoryMiB := resource.MustParse("1Mi")
+	cpuCoreFloat := cpuCore.AsApproximateFloat64()
+	memoryMiBFloat := memoryMiB.AsApproximateFloat64()
+
+	reserved := func(name corev1.ResourceName) float64 {
+		capacity := node.Status.Capacity[name]
+		allocatable := node.Status.Allocatable[name]
+		return max(capacity.AsApproximateFloat64()-allocatable.AsApproximateFloat64(), 0)
+	}
+	// Memory capacity includes the hugepages, which are requested by the pods and not reserved
+	reservedMemory := reserved(corev1.ResourceMemory)
+	for name, quantity := range node.Status.Capacity {
+		if strings.HasPrefix(name.String(), corev1.ResourceHugePagesPrefix) {
+			reservedMemory = max(reservedMemory-quantity.AsApproximateFloat64(), 0)
+		}
+	}
+	hourlyCosts := map[string]float64{
+		corev1.ResourceCPU.String():    reserved(corev1.ResourceCPU) / cpuCoreFloat * cpuCoreHourlyCost,
+		corev1.ResourceMemory.String(): reservedMemory / memoryMiBFloat * memoryMiBHourlyCost,
+	}
+	if unitHourlyCost, found := extendedUnitHourlyCosts[corev1.ResourceEphemeralStorage.String()]; found {
+		hourlyCosts[corev1.ResourceEphemeralStorage.String()] = reserved(corev1.ResourceEphemeralStorage) * unitHourlyCost
+	}
+
+	for name, hourlyCost := range hourlyCosts {
+		monitoring.NodeReservedHourlyCostMetric.WithLabelValues(node.Name, node.Name, name).Set(hourlyCost)
+	}
+}
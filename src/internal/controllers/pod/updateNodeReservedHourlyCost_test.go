@@ -0,0 +1,72 @@
+// Copyright 2025 The MoneyPod Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pod
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/vlasov-y/moneypod/internal/monitoring"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = Describe("updateNodeReservedHourlyCost", func() {
+	node := &corev1.Node{
+		ObjectMeta: metav1.ObjectMeta{Name: "reserved"},
+		Status: corev1.NodeStatus{
+			Capacity: corev1.ResourceList{
+				corev1.ResourceCPU:              resource.MustParse("4"),
+				corev1.ResourceMemory:           resource.MustParse("4Gi"),
+				corev1.ResourceEphemeralStorage: resource.MustParse("100Gi"),
+			},
+			Allocatable: corev1.ResourceList{
+				corev1.ResourceCPU:              resource.MustParse("3500m"),
+				corev1.ResourceMemory:           resource.MustParse("3Gi"),
+				corev1.ResourceEphemeralStorage: resource.MustParse("90Gi"),
+			},
+		},
+	}
+	extended := map[string]float64{"ephemeral-storage": 1.0 / (1024 * 1024 * 1024)}
+
+	It("should export the reserved resources cost with the capacity basis", func() {
+		r := &PodReconciler{Options: PodOptions{PricingBasis: PricingBasisCapacity}}
+		r.updateNodeReservedHourlyCost(node, 1, 1.0/1024, extended)
+		Expect(testutil.ToFloat64(monitoring.NodeReservedHourlyCostMetric.WithLabelValues(
+			"reserved", "reserved", "cpu"))).To(BeNumerically("~", 0.5, 1e-9))
+		Expect(testutil.ToFloat64(monitoring.NodeReservedHourlyCostMetric.WithLabelValues(
+			"reserved", "reserved", "memory"))).To(BeNumerically("~", 1, 1e-9))
+		Expect(testutil.ToFloat64(monitoring.NodeReservedHourlyCostMetric.WithLabelValues(
+			"reserved", "reserved", "ephemeral-storage"))).To(BeNumerically("~", 10, 1e-9))
+	})
+
+	It("should not report the hugepages as reserved", func() {
+		hugePages := node.DeepCopy()
+		hugePages.Status.Capacity[corev1.ResourceMemory] = resource.MustParse("8Gi")
+		hugePages.Status.Capacity["hugepages-2Mi"] = resource.MustParse("4Gi")
+		hugePages.Status.Allocatable["hugepages-2Mi"] = resource.MustParse("4Gi")
+		r := &PodReconciler{Options: PodOptions{PricingBasis: PricingBasisCapacity}}
+		r.updateNodeReservedHourlyCost(hugePages, 1, 1.0/1024, extended)
+		Expect(testutil.ToFloat64(monitoring.NodeReservedHourlyCostMetric.WithLabelValues(
+			"reserved", "reserved", "memory"))).To(BeNumerically("~", 1, 1e-9))
+	})
+
+	It("should drop the metric with the allocatable basis", func() {
+		r := &PodReconciler{Options: PodOptions{PricingBasis: PricingBasisAllocatable}}
+		r.updateNodeReservedHourlyCost(node, 1, 1.0/1024, extended)
+		Expect(testutil.CollectAndCount(monitoring.NodeReservedHourlyCostMetric)).To(BeZero())
+	})
+})
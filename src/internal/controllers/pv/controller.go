@@ -0,0 +1,144 @@
+// Copyright 2025 The MoneyPod Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+// Package pv provides Kubernetes controller implementations for persistent volumes cost management.
+package pv
+
+import (
+	"context"
+
+	. "github.com/vlasov-y/moneypod/internal/providers"
+	. "github.com/vlasov-y/moneypod/internal/types"
+	. "github.com/vlasov-y/moneypod/internal/utils"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+// Index of the pods by the persistent volume claims they mount
+const indexClaimName = ".spec.volumes.persistentVolumeClaim.claimName"
+
+// PersistentVolumeReconciler reconciles a PersistentVolume object
+type PersistentVolumeReconciler struct {
+	Reconciler
+}
+
+// +kubebuilder:rbac:groups="",resources=persistentvolumes,verbs=get;list;watch;update;patch
+// +kubebuilder:rbac:groups="",resources=persistentvolumeclaims,verbs=get;list;watch
+
+func (r *PersistentVolumeReconciler) Reconcile(ctx context.Context, req ctrl.Request) (result ctrl.Result, err error) {
+	log := logf.FromContext(ctx)
+
+	pv := corev1.PersistentVolume{}
+	if err = r.Get(ctx, req.NamespacedName, &pv); err != nil {
+		// Object does not exist, ignore the event and return
+		if !errors.IsNotFound(err) {
+			log.Error(err, "cannot get the persistent volume")
+		}
+		return result, client.IgnoreNotFound(err)
+	}
+	log = log.WithValues("pv", pv.Name)
+
+	// Handle deletion
+	if pv.GetDeletionTimestamp() != nil {
+		deletePVMetrics(&pv)
+		return
+	}
+
+	// Skip volumes the providers cannot price
+	if NewVolumeProvider(&pv) == nil {
+		log.V(1).Info("persistent volume cost is not supported")
+		return
+	}
+
+	// Manage hourly cost
+	var hourlyCost float64
+	if hourlyCost, err = r.updateHourlyCost(ctx, &pv); err != nil {
+		if CheckRequeue(err) {
+			err = nil
+			return RequeueResult, err
+		}
+		return
+	}
+	// If cost is unknown
+	if hourlyCost < 0 {
+		return
+	}
+
+	// Cost is attributed to the claim namespace and the workload mounting it
+	var info PVInfo
+	if pv.Spec.ClaimRef != nil {
+		info.Namespace = pv.Spec.ClaimRef.Namespace
+		info.Claim = pv.Spec.ClaimRef.Name
+		if info.Owner.Kind, info.Owner.Name, err = r.getOwner(ctx, info.Namespace, info.Claim); err != nil {
+			return
+		}
+	}
+	createPVMetrics(&pv, hourlyCost, &info)
+
+	// Periodic cost refresh
+	return ctrl.Result{RequeueAfter: CostRefreshInterval}, err
+}
+
+func (r *PersistentVolumeReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	// Register index: spec.volumes.persistentVolumeClaim.claimName → pod
+	if err := mgr.GetFieldIndexer().IndexField(context.Background(),
+		&corev1.Pod{}, indexClaimName,
+		func(obj client.Object) []string {
+			pod := obj.(*corev1.Pod)
+			var claims []string
+			for _, volume := range pod.Spec.Volumes {
+				if volume.PersistentVolumeClaim != nil {
+					claims = append(claims, volume.PersistentVolumeClaim.ClaimName)
+				}
+			}
+			return claims
+		}); err != nil {
+		return err
+	}
+
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&corev1.PersistentVolume{}).
+		// Watch Pods to update the workload mounting the volume
+		Watches(
+			&corev1.Pod{},
+			handler.EnqueueRequestsFromMapFunc(func(ctx context.Context, obj client.Object) []reconcile.Request {
+				pod := obj.(*corev1.Pod)
+				var requests []reconcile.Request
+				for _, volume := range pod.Spec.Volumes {
+					if volume.PersistentVolumeClaim == nil {
+						continue
+					}
+					pvc := corev1.PersistentVolumeClaim{}
+					if err := r.Get(ctx, types.NamespacedName{
+						Namespace: pod.Namespace,
+						Name:      volume.PersistentVolumeClaim.ClaimName,
+					}, &pvc); err != nil || pvc.Spec.VolumeName == "" {
+						continue
+					}
+					requests = append(requests, reconcile.Request{
+						NamespacedName: types.NamespacedName{Name: pvc.Spec.VolumeName},
+					})
+				}
+				return requests
+			}),
+		).
+		Named("pv").
+		Complete(r)
+}
@@ -0,0 +1,106 @@
+// Copyright 2025 The MoneyPod Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package pv
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/vlasov-y/moneypod/internal/types"
+	. "github.com/vlasov-y/moneypod/test/utils"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+var _ = Describe("PersistentVolumeReconciler", Ordered, func() {
+	var (
+		pv    *corev1.PersistentVolume
+		pvKey types.NamespacedName
+		req   reconcile.Request
+	)
+
+	BeforeEach(func() {
+		pv = NewFakePersistentVolume()
+		pv.SetAnnotations(map[string]string{
+			AnnotationVolumeHourlyCost: "0.01",
+			AnnotationCostUpdatedAt:    time.Now().UTC().Format(time.RFC3339),
+		})
+		pvKey = types.NamespacedName{Name: pv.Name}
+		req = reconcile.Request{NamespacedName: pvKey}
+	})
+
+	JustBeforeEach(func() {
+		Expect(c.Create(ctx, pv)).To(Succeed())
+		Expect(c.Get(ctx, pvKey, pv)).To(Succeed())
+	})
+
+	AfterEach(func() {
+		Expect(c.Get(ctx, pvKey, pv)).To(Succeed())
+		pv.SetFinalizers([]string{})
+		Expect(c.Update(ctx, pv)).To(Succeed())
+		if pv.GetDeletionTimestamp() == nil {
+			Expect(c.Delete(ctx, pv)).To(Succeed())
+		}
+	})
+
+	Context("when reconciling an EBS volume with a fresh cost", func() {
+		It("should requeue for the periodic refresh", func() {
+			result, err := reconciler.Reconcile(ctx, req)
+			ExpectWithOffset(1, err).NotTo(HaveOccurred())
+			ExpectWithOffset(2, result.RequeueAfter).To(Equal(CostRefreshInterval))
+		})
+	})
+
+	Context("when reconciling an unsupported volume", func() {
+		BeforeEach(func() {
+			pv.Spec.CSI.Driver = "efs.csi.aws.com"
+			pv.Annotations = nil
+		})
+
+		It("should skip it", func() {
+			result, err := reconciler.Reconcile(ctx, req)
+			ExpectWithOffset(1, err).NotTo(HaveOccurred())
+			ExpectWithOffset(2, result).To(Equal(ctrl.Result{}))
+			Expect(c.Get(ctx, pvKey, pv)).To(Succeed())
+			Expect(pv.Annotations).ToNot(HaveKey(AnnotationVolumeHourlyCost))
+		})
+	})
+
+	Context("when persistent volume is being deleted", func() {
+		JustBeforeEach(func() {
+			pv.SetFinalizers([]string{"unit.test/finalizer"})
+			Expect(c.Update(ctx, pv)).To(Succeed())
+			Expect(c.Delete(ctx, pv)).To(Succeed())
+		})
+
+		It("should handle deletion gracefully", func() {
+			result, err := reconciler.Reconcile(ctx, req)
+			ExpectWithOffset(1, err).NotTo(HaveOccurred())
+			ExpectWithOffset(2, result).To(Equal(ctrl.Result{}))
+		})
+	})
+
+	Context("when persistent volume does not exist", func() {
+		It("should ignore not found errors", func() {
+			req := reconcile.Request{NamespacedName: types.NamespacedName{Name: "non-existent-pv"}}
+			result, err := reconciler.Reconcile(ctx, req)
+			ExpectWithOffset(1, err).NotTo(HaveOccurred())
+			Expect(result).To(Equal(ctrl.Result{}))
+		})
+	})
+})
@@ -0,0 +1,61 @@
+// Copyright 2025 The MoneyPod Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package pv
+
+import (
+	"context"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+// getOwner returns the workload of the first pod mounting the claim, Deployment is returned for ReplicaSet pods
+func (r *PersistentVolumeReconciler) getOwner(ctx context.Context,
+	namespace string, claim string) (kind string, name string, err error) {
+	log := logf.FromContext(ctx)
+
+	var pods corev1.PodList
+	if err = r.List(ctx, &pods, client.InNamespace(namespace), client.MatchingFields{indexClaimName: claim}); err != nil {
+		log.Error(err, "cannot list the pods mounting the claim")
+		return
+	}
+	for _, pod := range pods.Items {
+		if len(pod.GetOwnerReferences()) == 0 {
+			continue
+		}
+		ownerRef := pod.GetOwnerReferences()[0]
+		kind, name = ownerRef.Kind, ownerRef.Name
+		// Get Deployment name for ReplicaSet
+		if ownerRef.Kind == "ReplicaSet" {
+			replicaset := appsv1.ReplicaSet{}
+			if err = r.Get(ctx, types.NamespacedName{Namespace: namespace, Name: ownerRef.Name}, &replicaset); err != nil {
+				if !errors.IsNotFound(err) {
+					log.Error(err, "cannot get the replicaset")
+					return
+				}
+				err = nil
+			}
+			if len(replicaset.GetOwnerReferences()) > 0 {
+				ownerRef = replicaset.GetOwnerReferences()[0]
+				kind, name = ownerRef.Kind, ownerRef.Name
+			}
+		}
+		return
+	}
+	return
+}
@@ -0,0 +1,34 @@
+// Copyright 2025 The MoneyPod Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package pv
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/vlasov-y/moneypod/internal/monitoring"
+	"github.com/vlasov-y/moneypod/internal/types"
+	corev1 "k8s.io/api/core/v1"
+)
+
+func deletePVMetrics(pv *corev1.PersistentVolume) {
+	monitoring.PVHourlyCostMetric.DeletePartialMatch(prometheus.Labels{
+		"name": pv.Name,
+	})
+}
+
+func createPVMetrics(pv *corev1.PersistentVolume, cost float64, info *types.PVInfo) {
+	deletePVMetrics(pv)
+	monitoring.PVHourlyCostMetric.WithLabelValues(
+		pv.Name, pv.Name, info.Namespace, info.Claim, pv.Spec.StorageClassName, info.Owner.Kind, info.Owner.Name,
+	).Set(cost)
+}
@@ -0,0 +1,100 @@
+// Copyright 2025 The MoneyPod Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package pv
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	. "github.com/vlasov-y/moneypod/internal/providers"
+	. "github.com/vlasov-y/moneypod/internal/types"
+	. "github.com/vlasov-y/moneypod/internal/utils"
+	corev1 "k8s.io/api/core/v1"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+func (r *PersistentVolumeReconciler) updateHourlyCost(ctx context.Context,
+	pv *corev1.PersistentVolume) (hourlyCost float64, err error) {
+	log := logf.FromContext(ctx)
+
+	annotations := pv.GetAnnotations()
+	if annotations == nil {
+		annotations = map[string]string{}
+	}
+
+	// Check hourly cost update transition time
+	costUpdatedAt := time.Unix(0, 0)
+	if value, exists := annotations[AnnotationCostUpdatedAt]; exists {
+		costUpdatedAt, _ = time.Parse(time.RFC3339, value)
+	}
+
+	// Update the price only if the last refresh was not successful or it is time to refresh
+	if annotations[AnnotationVolumeHourlyCost] == UnknownCost || time.Since(costUpdatedAt) > CostRefreshInterval {
+		log.V(1).Info("fetching new persistent volume hourly cost")
+
+		provider := NewVolumeProvider(pv)
+		if hourlyCost, err = provider.GetPersistentVolumeHourlyCost(ctx, r.Recorder, pv); err != nil {
+			if CheckRequeue(err) {
+				return hourlyCost, ErrRequestRequeue
+			}
+			return
+		}
+
+		if hourlyCost > 0 {
+			log.V(1).Info("fetched hourly cost successfully", "hourlyCost", hourlyCost)
+			annotations[AnnotationVolumeHourlyCost] = strconv.FormatFloat(hourlyCost, 'f', 10, 64)
+			annotations[AnnotationCostUpdatedAt] = time.Now().UTC().Format(time.RFC3339)
+		} else {
+			log.V(1).Info("hourly cost is unknown", "hourlyCost", hourlyCost)
+			annotations[AnnotationVolumeHourlyCost] = UnknownCost
+		}
+
+		pv.SetAnnotations(annotations)
+		if err = r.Update(ctx, pv); err != nil {
+			if strings.Contains(err.Error(), "please apply your changes to the latest version and try again") {
+				err = nil
+				log.V(1).Info("requeue because of the update conflict")
+				return hourlyCost, ErrRequestRequeue
+			}
+			log.Error(err, "failed to update the persistent volume object")
+			r.Recorder.Eventf(pv, corev1.EventTypeWarning, "UpdatePersistentVolumeFailed", err.Error())
+			return
+		}
+	}
+
+	// Handle unknown cost in one place
+	if annotations[AnnotationVolumeHourlyCost] == UnknownCost {
+		hourlyCost = -1
+		return
+	}
+
+	// Parse cost from annotation as float
+	if hourlyCost, err = strconv.ParseFloat(annotations[AnnotationVolumeHourlyCost], 64); err != nil {
+		msg := fmt.Sprintf("failed to parse the cost: %s", annotations[AnnotationVolumeHourlyCost])
+		log.Error(err, msg)
+		// Broken price is fetched again on the next reconcile
+		delete(annotations, AnnotationCostUpdatedAt)
+		pv.SetAnnotations(annotations)
+		if err = r.Update(ctx, pv); err != nil {
+			log.Error(err, "failed to update the persistent volume object")
+			return
+		}
+		return hourlyCost, ErrRequestRequeue
+	}
+
+	return
+}
@@ -0,0 +1,70 @@
+// Copyright 2025 The MoneyPod Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cur
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	. "github.com/vlasov-y/moneypod/internal/types"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+// annotateNodes sets the correction factor annotation on the nodes found in the report and removes it from the rest
+func (job *Job) annotateNodes(ctx context.Context, factors map[string]float64) (err error) {
+	log := logf.FromContext(ctx)
+
+	nodes := corev1.NodeList{}
+	if err = job.List(ctx, &nodes); err != nil {
+		log.Error(err, "failed to list nodes")
+		return
+	}
+
+	for i := range nodes.Items {
+		node := &nodes.Items[i]
+		// aws:///eu-central-1a/i-0123456789abcdef0
+		id := node.Spec.ProviderID[strings.LastIndex(node.Spec.ProviderID, "/")+1:]
+		factor, found := factors[id]
+		value, exists := node.Annotations[AnnotationNodeCorrectionFactor]
+
+		patch := client.MergeFrom(node.DeepCopy())
+		switch {
+		case found:
+			newValue := strconv.FormatFloat(factor, 'f', 6, 64)
+			if exists && value == newValue {
+				continue
+			}
+			if node.Annotations == nil {
+				node.Annotations = map[string]string{}
+			}
+			node.Annotations[AnnotationNodeCorrectionFactor] = newValue
+		case exists:
+			// New nodes are not in the report yet, old factors are dropped as well
+			delete(node.Annotations, AnnotationNodeCorrectionFactor)
+		default:
+			continue
+		}
+
+		if err = job.Patch(ctx, node, patch); err != nil {
+			log.Error(err, "failed to annotate the node", "node", node.Name)
+			return
+		}
+		log.V(1).Info("updated the correction factor", "node", node.Name, "factor", node.Annotations[AnnotationNodeCorrectionFactor])
+	}
+	return
+}
@@ -0,0 +1,89 @@
+// Copyright 2025 The MoneyPod Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cur
+
+import (
+	"strings"
+	"time"
+)
+
+// Line item types of the instance hours
+const (
+	lineItemTypeUsage                   = "Usage"
+	lineItemTypeDiscountedUsage         = "DiscountedUsage"
+	lineItemTypeSavingsPlanCoveredUsage = "SavingsPlanCoveredUsage"
+	lineItemTypeSavingsPlanNegation     = "SavingsPlanNegation"
+)
+
+// getCorrectionFactors returns the ratio of the invoiced to the list cost per instance for the last day in the report.
+// List cost is the public on-demand or the spot price, invoiced cost includes reservations, savings plans,
+// credits and discounts. Instances without the list cost are skipped.
+func getCorrectionFactors(items []lineItem) (factors map[string]float64) {
+	factors = map[string]float64{}
+
+	// Report is delayed, so the last day of the data is used instead of the last day before now
+	var latest time.Time
+	netAvailable := false
+	for _, item := range items {
+		if item.usageStartDate.After(latest) {
+			latest = item.usageStartDate
+		}
+		netAvailable = netAvailable || item.hasNetUnblendedCost
+	}
+	since := latest.Add(-23 * time.Hour)
+
+	listCosts := map[string]float64{}
+	invoicedCosts := map[string]float64{}
+	for _, item := range items {
+		if item.usageStartDate.Before(since) {
+			continue
+		}
+		id := item.resourceID
+		switch item.lineItemType {
+		case lineItemTypeUsage:
+			// Spot usage is billed at the spot price, it has no on-demand equivalent
+			if strings.Contains(item.usageType, "SpotUsage") || item.publicOnDemandCost == 0 {
+				listCosts[id] += item.unblendedCost
+			} else {
+				listCosts[id] += item.publicOnDemandCost
+			}
+			if item.hasNetUnblendedCost {
+				invoicedCosts[id] += item.netUnblendedCost
+			} else {
+				invoicedCosts[id] += item.unblendedCost
+			}
+		case lineItemTypeDiscountedUsage:
+			listCosts[id] += item.publicOnDemandCost
+			invoicedCosts[id] += item.reservationEffectiveCost
+		case lineItemTypeSavingsPlanCoveredUsage:
+			listCosts[id] += item.publicOnDemandCost
+			invoicedCosts[id] += item.savingsPlanEffectiveCost
+		case lineItemTypeSavingsPlanNegation:
+			// Negates the unblended cost of the covered usage, effective cost is used instead
+		default:
+			// Credits and discounts are already subtracted in the net cost if it is exported
+			if !netAvailable {
+				invoicedCosts[id] += item.unblendedCost
+			}
+		}
+	}
+
+	for id, listCost := range listCosts {
+		if listCost > 0 {
+			factors[id] = max(invoicedCosts[id], 0) / listCost
+		}
+	}
+	return
+}
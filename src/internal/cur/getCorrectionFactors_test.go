@@ -0,0 +1,87 @@
+// Copyright 2025 The MoneyPod Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cur
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("getCorrectionFactors", func() {
+	day := time.Date(2025, 10, 1, 0, 0, 0, 0, time.UTC)
+
+	usage := func(id, lineItemType, usageType string, hour int) lineItem {
+		return lineItem{
+			productCode:    "AmazonEC2",
+			lineItemType:   lineItemType,
+			resourceID:     id,
+			usageType:      usageType,
+			usageStartDate: day.Add(time.Duration(hour) * time.Hour),
+			usageAmount:    1,
+		}
+	}
+
+	It("should divide the net cost by the public on-demand cost", func() {
+		item := usage("i-1", lineItemTypeUsage, "EUC1-BoxUsage:m5.large", 10)
+		item.unblendedCost, item.publicOnDemandCost = 0.115, 0.115
+		item.netUnblendedCost, item.hasNetUnblendedCost = 0.092, true
+		Expect(getCorrectionFactors([]lineItem{item})).To(HaveKeyWithValue("i-1", BeNumerically("~", 0.8, 1e-9)))
+	})
+
+	It("should use the effective cost of reserved and savings plan usage", func() {
+		reserved := usage("i-1", lineItemTypeDiscountedUsage, "EUC1-BoxUsage:m5.large", 10)
+		reserved.publicOnDemandCost, reserved.reservationEffectiveCost = 0.1, 0.06
+		covered := usage("i-2", lineItemTypeSavingsPlanCoveredUsage, "EUC1-BoxUsage:m5.large", 10)
+		covered.publicOnDemandCost, covered.savingsPlanEffectiveCost = 0.1, 0.07
+		negation := usage("i-2", lineItemTypeSavingsPlanNegation, "EUC1-BoxUsage:m5.large", 10)
+		negation.unblendedCost = -0.1
+
+		factors := getCorrectionFactors([]lineItem{reserved, covered, negation})
+		Expect(factors).To(HaveKeyWithValue("i-1", BeNumerically("~", 0.6, 1e-9)))
+		Expect(factors).To(HaveKeyWithValue("i-2", BeNumerically("~", 0.7, 1e-9)))
+	})
+
+	It("should compare spot usage with the spot price", func() {
+		item := usage("i-1", lineItemTypeUsage, "EUC1-SpotUsage:m5.large", 10)
+		item.unblendedCost, item.publicOnDemandCost = 0.04, 0.115
+		Expect(getCorrectionFactors([]lineItem{item})).To(HaveKeyWithValue("i-1", BeNumerically("~", 1, 1e-9)))
+	})
+
+	It("should subtract credits only without the net cost", func() {
+		item := usage("i-1", lineItemTypeUsage, "EUC1-BoxUsage:m5.large", 10)
+		item.unblendedCost, item.publicOnDemandCost = 0.1, 0.1
+		credit := usage("i-1", "Credit", "EUC1-BoxUsage:m5.large", 10)
+		credit.unblendedCost = -0.03
+		Expect(getCorrectionFactors([]lineItem{item, credit})).To(HaveKeyWithValue("i-1", BeNumerically("~", 0.7, 1e-9)))
+
+		item.netUnblendedCost, item.hasNetUnblendedCost = 0.05, true
+		Expect(getCorrectionFactors([]lineItem{item, credit})).To(HaveKeyWithValue("i-1", BeNumerically("~", 0.5, 1e-9)))
+	})
+
+	It("should use only the last day of the report", func() {
+		old := usage("i-1", lineItemTypeUsage, "EUC1-BoxUsage:m5.large", 0)
+		old.unblendedCost, old.publicOnDemandCost = 0.01, 0.1
+		recent := usage("i-1", lineItemTypeUsage, "EUC1-BoxUsage:m5.large", 30)
+		recent.unblendedCost, recent.publicOnDemandCost = 0.09, 0.1
+		Expect(getCorrectionFactors([]lineItem{old, recent})).To(HaveKeyWithValue("i-1", BeNumerically("~", 0.9, 1e-9)))
+	})
+
+	It("should skip instances without the list cost", func() {
+		item := usage("i-1", lineItemTypeUsage, "EUC1-BoxUsage:m5.large", 10)
+		Expect(getCorrectionFactors([]lineItem{item})).To(BeEmpty())
+	})
+})
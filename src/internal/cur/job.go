@@ -0,0 +1,121 @@
+// Copyright 2025 The MoneyPod Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package cur reconciles the node prices with the AWS Cost and Usage Report 2.0 exports.
+package cur
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	providersAWS "github.com/vlasov-y/moneypod/internal/providers/aws"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+// Options configures the CUR true-up job.
+type Options struct {
+	// Bucket with the CUR 2.0 exports, the job is disabled if empty
+	Bucket string
+	// Key prefix of the export, e.g. exports/my-export/data
+	Prefix string
+	// Bucket region, taken from the environment if empty
+	Region string
+	// Custom S3 endpoint, e.g. MinIO
+	Endpoint string
+	// Address the bucket in the path instead of the host name, required by MinIO
+	PathStyle bool
+	// How often the report is read
+	Interval time.Duration
+	// Credentials, role and retries shared with the AWS provider
+	AWS providersAWS.Options
+}
+
+// Job periodically reads the report and annotates the nodes with their correction factors.
+type Job struct {
+	client.Client
+	s3   *s3.Client
+	opts Options
+}
+
+// NewJob creates the job with the S3 client using the same credentials and role as the AWS provider.
+func NewJob(ctx context.Context, c client.Client, opts Options) (job *Job, err error) {
+	log := logf.FromContext(ctx)
+
+	if opts.Bucket == "" {
+		err = errors.New("CUR bucket is required")
+		log.Error(err, "invalid CUR options")
+		return
+	}
+	if opts.Interval <= 0 {
+		err = errors.New("CUR interval must be positive")
+		log.Error(err, "invalid CUR options")
+		return
+	}
+	if opts.AWS.NoCredentials {
+		err = errors.New("CUR bucket cannot be read without AWS credentials")
+		log.Error(err, "invalid CUR options")
+		return
+	}
+
+	var loadOptions []func(*config.LoadOptions) error
+	if opts.Region != "" {
+		loadOptions = append(loadOptions, config.WithRegion(opts.Region))
+	}
+	var awsConfig aws.Config
+	if awsConfig, err = providersAWS.LoadConfig(ctx, opts.AWS, loadOptions...); err != nil {
+		return
+	}
+
+	job = &Job{
+		Client: c,
+		opts:   opts,
+		s3: s3.NewFromConfig(awsConfig, func(o *s3.Options) {
+			if opts.Endpoint != "" {
+				o.BaseEndpoint = aws.String(opts.Endpoint)
+			}
+			o.UsePathStyle = opts.PathStyle
+		}),
+	}
+	return
+}
+
+// Start runs the job right away and then every interval until the context is cancelled.
+func (job *Job) Start(ctx context.Context) error {
+	log := logf.FromContext(ctx).WithName("cur")
+	ctx = logf.IntoContext(ctx, log)
+
+	ticker := time.NewTicker(job.opts.Interval)
+	defer ticker.Stop()
+	for {
+		// Failed run is retried on the next tick, the previous factors stay in place
+		if err := job.run(ctx); err != nil {
+			log.Error(err, "CUR true-up failed")
+		}
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// NeedLeaderElection makes only the leader annotate the nodes.
+func (job *Job) NeedLeaderElection() bool {
+	return true
+}
@@ -0,0 +1,51 @@
+// Copyright 2025 The MoneyPod Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cur
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	providersAWS "github.com/vlasov-y/moneypod/internal/providers/aws"
+)
+
+var _ = Describe("NewJob", func() {
+	It("should use the region of the bucket and the provider identity", func() {
+		GinkgoT().Setenv("AWS_REGION", "eu-central-1")
+		job, err := NewJob(context.Background(), nil, Options{
+			Bucket:   "billing",
+			Region:   "us-east-1",
+			Interval: time.Hour,
+			AWS: providersAWS.Options{
+				RoleARN:     "arn:aws:iam::123456789012:role/billing",
+				MaxAttempts: 5,
+			},
+		})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(job.s3.Options().Region).To(Equal("us-east-1"))
+		Expect(job.s3.Options().Retryer.MaxAttempts()).To(Equal(5))
+	})
+
+	It("should require the credentials", func() {
+		_, err := NewJob(context.Background(), nil, Options{
+			Bucket:   "billing",
+			Interval: time.Hour,
+			AWS:      providersAWS.Options{NoCredentials: true},
+		})
+		Expect(err).To(HaveOccurred())
+	})
+})
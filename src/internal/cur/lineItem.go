@@ -0,0 +1,112 @@
+// Copyright 2025 The MoneyPod Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cur
+
+import (
+	"strconv"
+	"strings"
+	"time"
+)
+
+// CUR 2.0 columns used for the true-up, everything else is skipped
+const (
+	columnProductCode              = "line_item_product_code"
+	columnLineItemType             = "line_item_line_item_type"
+	columnResourceID               = "line_item_resource_id"
+	columnUsageType                = "line_item_usage_type"
+	columnUsageStartDate           = "line_item_usage_start_date"
+	columnUsageAmount              = "line_item_usage_amount"
+	columnUnblendedCost            = "line_item_unblended_cost"
+	columnNetUnblendedCost         = "line_item_net_unblended_cost"
+	columnPublicOnDemandCost       = "pricing_public_on_demand_cost"
+	columnReservationEffectiveCost = "reservation_effective_cost"
+	columnSavingsPlanEffectiveCost = "savings_plan_savings_plan_effective_cost"
+)
+
+// Usage types of the instance hours: BoxUsage, SpotUsage, DedicatedUsage, HostBoxUsage, etc.
+var instanceUsageTypes = []string{"BoxUsage", "SpotUsage", "DedicatedUsage"}
+
+// lineItem is a single CUR row of the instance usage, discount or credit.
+type lineItem struct {
+	productCode              string
+	lineItemType             string
+	resourceID               string
+	usageType                string
+	usageStartDate           time.Time
+	usageAmount              float64
+	unblendedCost            float64
+	netUnblendedCost         float64
+	hasNetUnblendedCost      bool
+	publicOnDemandCost       float64
+	reservationEffectiveCost float64
+	savingsPlanEffectiveCost float64
+}
+
+// isCURColumn reports whether the column must be read
+func isCURColumn(column string) bool {
+	switch column {
+	case columnProductCode, columnLineItemType, columnResourceID, columnUsageType, columnUsageStartDate,
+		columnUsageAmount, columnUnblendedCost, columnNetUnblendedCost, columnPublicOnDemandCost,
+		columnReservationEffectiveCost, columnSavingsPlanEffectiveCost:
+		return true
+	}
+	return false
+}
+
+// set parses the column value into the respective field, empty values are left zero
+func (item *lineItem) set(column string, value string) (err error) {
+	if value == "" {
+		return
+	}
+	switch column {
+	case columnProductCode:
+		item.productCode = value
+	case columnLineItemType:
+		item.lineItemType = value
+	case columnResourceID:
+		item.resourceID = value
+	case columnUsageType:
+		item.usageType = value
+	case columnUsageStartDate:
+		item.usageStartDate, err = time.Parse(time.RFC3339, value)
+	case columnUsageAmount:
+		item.usageAmount, err = strconv.ParseFloat(value, 64)
+	case columnUnblendedCost:
+		item.unblendedCost, err = strconv.ParseFloat(value, 64)
+	case columnNetUnblendedCost:
+		item.netUnblendedCost, err = strconv.ParseFloat(value, 64)
+		item.hasNetUnblendedCost = err == nil
+	case columnPublicOnDemandCost:
+		item.publicOnDemandCost, err = strconv.ParseFloat(value, 64)
+	case columnReservationEffectiveCost:
+		item.reservationEffectiveCost, err = strconv.ParseFloat(value, 64)
+	case columnSavingsPlanEffectiveCost:
+		item.savingsPlanEffectiveCost, err = strconv.ParseFloat(value, 64)
+	}
+	return
+}
+
+// isInstanceUsage reports whether the row is about the EC2 instance hours
+func (item *lineItem) isInstanceUsage() bool {
+	if item.productCode != "AmazonEC2" || !strings.HasPrefix(item.resourceID, "i-") {
+		return false
+	}
+	for _, usageType := range instanceUsageTypes {
+		if strings.Contains(item.usageType, usageType) {
+			return true
+		}
+	}
+	return false
+}
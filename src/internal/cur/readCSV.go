@@ -0,0 +1,69 @@
+// Copyright 2025 The MoneyPod Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cur
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// readCSV reads the instance usage rows of the CSV report file
+func readCSV(reader io.Reader) (items []lineItem, err error) {
+	r := csv.NewReader(reader)
+	r.ReuseRecord = true
+
+	var header []string
+	if header, err = r.Read(); err != nil {
+		return
+	}
+	// Header is reused by the next read, so the indexes are copied
+	columns := map[int]string{}
+	for i, name := range header {
+		// CUR 1.0 style "lineItem/UsageType" headers are not supported, CUR 2.0 uses snake case
+		if name = strings.TrimSpace(name); isCURColumn(name) {
+			columns[i] = name
+		}
+	}
+	if len(columns) == 0 {
+		err = errors.New("no CUR 2.0 columns found in the CSV header")
+		return
+	}
+
+	for {
+		var record []string
+		if record, err = r.Read(); err != nil {
+			if errors.Is(err, io.EOF) {
+				err = nil
+			}
+			return
+		}
+		var item lineItem
+		for i, column := range columns {
+			if i >= len(record) {
+				continue
+			}
+			if err = item.set(column, record[i]); err != nil {
+				err = fmt.Errorf("failed to parse %s: %w", column, err)
+				return
+			}
+		}
+		if item.isInstanceUsage() {
+			items = append(items, item)
+		}
+	}
+}
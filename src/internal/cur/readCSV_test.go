@@ -0,0 +1,46 @@
+// Copyright 2025 The MoneyPod Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cur
+
+import (
+	"strings"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+const reportCSV = `bill_payer_account_id,line_item_line_item_type,line_item_product_code,line_item_resource_id,line_item_usage_type,line_item_usage_start_date,line_item_unblended_cost,pricing_public_on_demand_cost
+111111111111,Usage,AmazonEC2,i-0123456789abcdef0,EUC1-BoxUsage:m5.large,2025-10-01T10:00:00Z,0.115,0.115
+111111111111,Usage,AmazonEC2,vol-0123456789abcdef0,EUC1-EBS:VolumeUsage.gp3,2025-10-01T10:00:00Z,0.01,0.01
+111111111111,Usage,AmazonS3,,EUC1-TimedStorage-ByteHrs,2025-10-01T10:00:00Z,0.02,0.02
+`
+
+var _ = Describe("readCSV", func() {
+	It("should read only the instance usage", func() {
+		items, err := readCSV(strings.NewReader(reportCSV))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(items).To(HaveLen(1))
+		Expect(items[0].resourceID).To(Equal("i-0123456789abcdef0"))
+		Expect(items[0].usageStartDate).To(Equal(time.Date(2025, 10, 1, 10, 0, 0, 0, time.UTC)))
+		Expect(items[0].publicOnDemandCost).To(Equal(0.115))
+		Expect(items[0].hasNetUnblendedCost).To(BeFalse())
+	})
+
+	It("should fail on the file without CUR 2.0 columns", func() {
+		_, err := readCSV(strings.NewReader("lineItem/UsageType,lineItem/UnblendedCost\nBoxUsage,1\n"))
+		Expect(err).To(HaveOccurred())
+	})
+})
@@ -0,0 +1,68 @@
+// Copyright 2025 The MoneyPod Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cur
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+// Report files are rewritten while the month lasts, older ones cannot have the last day of usage
+const lookback = 72 * time.Hour
+
+// readLineItems reads the instance usage from the report files updated recently
+func (job *Job) readLineItems(ctx context.Context) (items []lineItem, err error) {
+	log := logf.FromContext(ctx)
+
+	since := time.Now().Add(-lookback)
+	paginator := s3.NewListObjectsV2Paginator(job.s3, &s3.ListObjectsV2Input{
+		Bucket: aws.String(job.opts.Bucket),
+		Prefix: aws.String(job.opts.Prefix),
+	})
+	for paginator.HasMorePages() {
+		var page *s3.ListObjectsV2Output
+		if page, err = paginator.NextPage(ctx); err != nil {
+			log.Error(err, "failed to list the report files", "bucket", job.opts.Bucket)
+			return
+		}
+		for _, object := range page.Contents {
+			key := aws.ToString(object.Key)
+			if object.LastModified == nil || object.LastModified.Before(since) {
+				continue
+			}
+			var fileItems []lineItem
+			switch {
+			case strings.HasSuffix(key, ".parquet"):
+				fileItems, err = job.readParquetObject(ctx, key)
+			case strings.HasSuffix(key, ".csv"), strings.HasSuffix(key, ".csv.gz"):
+				fileItems, err = job.readCSVObject(ctx, key)
+			default:
+				continue
+			}
+			if err != nil {
+				log.Error(err, "failed to read the report file", "key", key)
+				return
+			}
+			log.V(1).Info("read the report file", "key", key, "items", len(fileItems))
+			items = append(items, fileItems...)
+		}
+	}
+	return
+}
@@ -0,0 +1,115 @@
+// Copyright 2025 The MoneyPod Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cur
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/parquet-go/parquet-go"
+)
+
+// readParquet reads the instance usage rows of the Parquet report file
+func readParquet(reader io.ReaderAt, size int64) (items []lineItem, err error) {
+	var file *parquet.File
+	if file, err = parquet.OpenFile(reader, size); err != nil {
+		return
+	}
+
+	// Leaf columns by index with the converters of their values to the CSV representation
+	type column struct {
+		name    string
+		convert func(parquet.Value) string
+	}
+	columns := map[int]column{}
+	for _, path := range file.Schema().Columns() {
+		name := strings.Join(path, ".")
+		if !isCURColumn(name) {
+			continue
+		}
+		leaf, _ := file.Schema().Lookup(path...)
+		columns[leaf.ColumnIndex] = column{name: name, convert: getParquetConverter(leaf.Node)}
+	}
+	if len(columns) == 0 {
+		err = errors.New("no CUR 2.0 columns found in the Parquet schema")
+		return
+	}
+
+	rows := parquet.NewReader(file)
+	defer rows.Close()
+	buffer := make([]parquet.Row, 1024)
+	for {
+		var n int
+		n, err = rows.ReadRows(buffer)
+		for _, row := range buffer[:n] {
+			var item lineItem
+			for _, value := range row {
+				c, exists := columns[value.Column()]
+				if !exists || value.IsNull() {
+					continue
+				}
+				if err = item.set(c.name, c.convert(value)); err != nil {
+					err = fmt.Errorf("failed to parse %s: %w", c.name, err)
+					return
+				}
+			}
+			if item.isInstanceUsage() {
+				items = append(items, item)
+			}
+		}
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				err = nil
+			}
+			return
+		}
+	}
+}
+
+// getParquetConverter returns the function formatting the column value the way it is written in CSV
+func getParquetConverter(node parquet.Node) func(parquet.Value) string {
+	if logical := node.Type().LogicalType(); logical != nil && logical.Timestamp != nil {
+		unit := time.Millisecond
+		switch {
+		case logical.Timestamp.Unit.Micros != nil:
+			unit = time.Microsecond
+		case logical.Timestamp.Unit.Nanos != nil:
+			unit = time.Nanosecond
+		}
+		return func(v parquet.Value) string {
+			return time.Unix(0, v.Int64()*int64(unit)).UTC().Format(time.RFC3339)
+		}
+	}
+	return func(v parquet.Value) string {
+		switch v.Kind() {
+		case parquet.ByteArray, parquet.FixedLenByteArray:
+			return string(v.ByteArray())
+		case parquet.Double:
+			return strconv.FormatFloat(v.Double(), 'f', -1, 64)
+		case parquet.Float:
+			return strconv.FormatFloat(float64(v.Float()), 'f', -1, 32)
+		case parquet.Int32:
+			return strconv.FormatInt(int64(v.Int32()), 10)
+		case parquet.Int64:
+			return strconv.FormatInt(v.Int64(), 10)
+		default:
+			return v.String()
+		}
+	}
+}
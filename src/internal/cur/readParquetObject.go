@@ -0,0 +1,49 @@
+// Copyright 2025 The MoneyPod Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cur
+
+import (
+	"context"
+	"io"
+	"os"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+)
+
+// readParquetObject downloads the Parquet file to disk since its footer is read first
+func (job *Job) readParquetObject(ctx context.Context, key string) (items []lineItem, err error) {
+	var output *s3.GetObjectOutput
+	if output, err = job.s3.GetObject(ctx, &s3.GetObjectInput{
+		Bucket: aws.String(job.opts.Bucket),
+		Key:    aws.String(key),
+	}); err != nil {
+		return
+	}
+	defer output.Body.Close()
+
+	var file *os.File
+	if file, err = os.CreateTemp("", "moneypod-cur-*.parquet"); err != nil {
+		return
+	}
+	defer os.Remove(file.Name())
+	defer file.Close()
+
+	var size int64
+	if size, err = io.Copy(file, output.Body); err != nil {
+		return
+	}
+	return readParquet(file, size)
+}
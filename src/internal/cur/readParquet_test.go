@@ -0,0 +1,77 @@
+// Copyright 2025 The MoneyPod Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cur
+
+import (
+	"bytes"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/parquet-go/parquet-go"
+)
+
+type reportRow struct {
+	BillPayerAccountID string    `parquet:"bill_payer_account_id"`
+	LineItemType       string    `parquet:"line_item_line_item_type"`
+	ProductCode        string    `parquet:"line_item_product_code"`
+	ResourceID         string    `parquet:"line_item_resource_id"`
+	UsageType          string    `parquet:"line_item_usage_type"`
+	UsageStartDate     time.Time `parquet:"line_item_usage_start_date,timestamp(millisecond)"`
+	UnblendedCost      float64   `parquet:"line_item_unblended_cost"`
+	NetUnblendedCost   *float64  `parquet:"line_item_net_unblended_cost,optional"`
+	PublicOnDemandCost float64   `parquet:"pricing_public_on_demand_cost"`
+}
+
+var _ = Describe("readParquet", func() {
+	It("should read only the instance usage", func() {
+		net := 0.09
+		buffer := bytes.Buffer{}
+		writer := parquet.NewGenericWriter[reportRow](&buffer)
+		_, err := writer.Write([]reportRow{
+			{
+				BillPayerAccountID: "111111111111", LineItemType: "Usage", ProductCode: "AmazonEC2",
+				ResourceID: "i-0123456789abcdef0", UsageType: "EUC1-BoxUsage:m5.large",
+				UsageStartDate: time.Date(2025, 10, 1, 10, 0, 0, 0, time.UTC),
+				UnblendedCost:  0.115, NetUnblendedCost: &net, PublicOnDemandCost: 0.115,
+			},
+			{
+				BillPayerAccountID: "111111111111", LineItemType: "Usage", ProductCode: "AmazonEC2",
+				ResourceID: "i-0123456789abcdef1", UsageType: "EUC1-SpotUsage:m5.large",
+				UsageStartDate: time.Date(2025, 10, 1, 10, 0, 0, 0, time.UTC),
+				UnblendedCost:  0.04, PublicOnDemandCost: 0.115,
+			},
+			{
+				BillPayerAccountID: "111111111111", LineItemType: "Usage", ProductCode: "AmazonEC2",
+				ResourceID: "vol-0123456789abcdef0", UsageType: "EUC1-EBS:VolumeUsage.gp3",
+				UsageStartDate: time.Date(2025, 10, 1, 10, 0, 0, 0, time.UTC),
+				UnblendedCost:  0.01, PublicOnDemandCost: 0.01,
+			},
+		})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(writer.Close()).To(Succeed())
+
+		items, err := readParquet(bytes.NewReader(buffer.Bytes()), int64(buffer.Len()))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(items).To(HaveLen(2))
+		Expect(items[0].resourceID).To(Equal("i-0123456789abcdef0"))
+		Expect(items[0].usageStartDate).To(Equal(time.Date(2025, 10, 1, 10, 0, 0, 0, time.UTC)))
+		Expect(items[0].netUnblendedCost).To(Equal(0.09))
+		Expect(items[0].hasNetUnblendedCost).To(BeTrue())
+		Expect(items[1].usageType).To(Equal("EUC1-SpotUsage:m5.large"))
+		Expect(items[1].hasNetUnblendedCost).To(BeFalse())
+		Expect(items[1].unblendedCost).To(Equal(0.04))
+	})
+})
@@ -0,0 +1,39 @@
+// Copyright 2025 The MoneyPod Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cur
+
+import (
+	"context"
+
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+// run reads the report and updates the node correction factors
+func (job *Job) run(ctx context.Context) (err error) {
+	log := logf.FromContext(ctx)
+
+	var items []lineItem
+	if items, err = job.readLineItems(ctx); err != nil {
+		return
+	}
+	if len(items) == 0 {
+		log.Info("no instance usage found in the report", "bucket", job.opts.Bucket, "prefix", job.opts.Prefix)
+		return
+	}
+
+	factors := getCorrectionFactors(items)
+	log.V(1).Info("computed correction factors", "instances", len(factors))
+	return job.annotateNodes(ctx, factors)
+}
@@ -29,32 +29,186 @@ var (
 		Subsystem: "node",
 		Name:      "hourly_cost",
 		Help:      "Node hourly cost.",
-	}, []string{"node", "name", "type", "capacity", "id", "availability_zone"})
+	}, []string{"node", "name", "type", "capacity", "id", "availability_zone", "currency", "license", "tenancy"})
+
+	NodeStorageHourlyCostMetric = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: metricsNamespace,
+		Subsystem: "node",
+		Name:      "storage_hourly_cost",
+		Help:      "Node disks hourly cost, included into the node hourly cost.",
+	}, []string{"node", "name"})
+
+	NodeCorrectionFactorMetric = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: metricsNamespace,
+		Subsystem: "node",
+		Name:      "correction_factor",
+		Help:      "Ratio of the invoiced to the list node cost from the Cost and Usage Report.",
+	}, []string{"node", "name", "id"})
+	NodeInvoicedHourlyCostMetric = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: metricsNamespace,
+		Subsystem: "node",
+		Name:      "invoiced_hourly_cost",
+		Help:      "Node hourly cost corrected with the Cost and Usage Report.",
+	}, []string{"node", "name", "type", "capacity", "id", "availability_zone", "currency", "license", "tenancy"})
+	NodeIdleHourlyCostMetric = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: metricsNamespace,
+		Subsystem: "node",
+		Name:      "idle_hourly_cost",
+		Help:      "Hourly cost of the node CPU and memory not requested by its pods.",
+	}, []string{"node", "name", "resource"})
+	NodeReservedHourlyCostMetric = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: metricsNamespace,
+		Subsystem: "node",
+		Name:      "reserved_hourly_cost",
+		Help:      "Hourly cost of the node capacity reserved for the system, the kubelet and the eviction threshold.",
+	}, []string{"node", "name", "resource"})
 
 	PodCPUHourlyCostMetric = prometheus.NewGaugeVec(prometheus.GaugeOpts{
 		Namespace: metricsNamespace,
 		Subsystem: "pod",
 		Name:      "cpu_hourly_cost",
 		Help:      "Pod CPU hourly cost for one CPU core.",
-	}, []string{"pod", "name", "namespace", "owner_kind", "owner_name", "node"})
+	}, []string{"pod", "name", "namespace", "owner_kind", "owner_name", "node", "split_strategy"})
 	PodMemoryHourlyCostMetric = prometheus.NewGaugeVec(prometheus.GaugeOpts{
 		Namespace: metricsNamespace,
 		Subsystem: "pod",
 		Name:      "memory_hourly_cost",
 		Help:      "Pod Memory hourly cost for one MiB.",
-	}, []string{"pod", "name", "namespace", "owner_kind", "owner_name", "node"})
+	}, []string{"pod", "name", "namespace", "owner_kind", "owner_name", "node", "split_strategy"})
 	PodRequestsHourlyCostMetric = prometheus.NewGaugeVec(prometheus.GaugeOpts{
 		Namespace: metricsNamespace,
 		Subsystem: "pod",
 		Name:      "requests_hourly_cost",
 		Help:      "Pod resources requests hourly cost.",
+	}, []string{"pod", "name", "namespace", "owner_kind", "owner_name", "node", "split_strategy", "requests_policy"})
+	PodGPUHourlyCostMetric = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: metricsNamespace,
+		Subsystem: "pod",
+		Name:      "gpu_hourly_cost",
+		Help:      "Pod requested GPUs and other extended resources hourly cost, included into the requests cost.",
+	}, []string{"pod", "name", "namespace", "owner_kind", "owner_name", "node", "resource"})
+	PodUsageHourlyCostMetric = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: metricsNamespace,
+		Subsystem: "pod",
+		Name:      "usage_hourly_cost",
+		Help:      "Pod CPU and memory usage hourly cost from the metrics API.",
+	}, []string{"pod", "name", "namespace", "owner_kind", "owner_name", "node"})
+	PodUsageCostMetric = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: metricsNamespace,
+		Subsystem: "pod",
+		Name:      "usage_cost_total",
+		Help:      "Pod CPU and memory usage cost integrated over time since the pod was first sampled.",
+	}, []string{"pod", "name", "namespace", "owner_kind", "owner_name", "node"})
+	PodEffectiveHourlyCostMetric = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: metricsNamespace,
+		Subsystem: "pod",
+		Name:      "effective_hourly_cost",
+		Help:      "Pod hourly cost of the bigger of the usage and the requests per CPU and memory, plus the extended resources.",
+	}, []string{"pod", "name", "namespace", "owner_kind", "owner_name", "node"})
+	PodEffectiveCostMetric = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: metricsNamespace,
+		Subsystem: "pod",
+		Name:      "effective_cost_total",
+		Help:      "Pod effective cost integrated over time, charged by the requests until the pod usage is sampled.",
 	}, []string{"pod", "name", "namespace", "owner_kind", "owner_name", "node"})
+	PodPendingEstimatedHourlyCostMetric = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: metricsNamespace,
+		Subsystem: "pod",
+		Name:      "pending_estimated_hourly_cost",
+		Help:      "Unscheduled pod requests hourly cost on the cheapest or the median node it fits.",
+	}, []string{"pod", "name", "namespace", "owner_kind", "owner_name", "estimate"})
+
+	ContainerRequestsHourlyCostMetric = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: metricsNamespace,
+		Subsystem: "container",
+		Name:      "requests_hourly_cost",
+		Help:      "Container resources requests hourly cost, native sidecars included, exited init containers skipped.",
+	}, []string{"pod", "name", "namespace", "owner_kind", "owner_name", "node", "container"})
+	ContainerUsageHourlyCostMetric = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: metricsNamespace,
+		Subsystem: "container",
+		Name:      "usage_hourly_cost",
+		Help:      "Container CPU and memory usage hourly cost from the metrics API.",
+	}, []string{"pod", "name", "namespace", "owner_kind", "owner_name", "node", "container"})
+
+	PVHourlyCostMetric = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: metricsNamespace,
+		Subsystem: "pv",
+		Name:      "hourly_cost",
+		Help:      "Persistent volume hourly cost.",
+	}, []string{"persistentvolume", "name", "namespace", "persistentvolumeclaim", "storage_class", "owner_kind", "owner_name"})
+
+	CapacityReservationUnusedHourlyCostMetric = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: metricsNamespace,
+		Subsystem: "capacity_reservation",
+		Name:      "unused_hourly_cost",
+		Help:      "Hourly cost of the reserved capacity no instance runs in.",
+	}, []string{"id", "type", "availability_zone"})
+
+	ClusterFixedHourlyCostMetric = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: metricsNamespace,
+		Subsystem: "cluster",
+		Name:      "fixed_hourly_cost",
+		Help:      "Hourly cost of the cluster not attributed to any pod: control plane fee, control plane nodes, etc.",
+	}, []string{"name"})
+	NamespaceFixedHourlyCostMetric = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: metricsNamespace,
+		Subsystem: "namespace",
+		Name:      "fixed_hourly_cost",
+		Help:      "Share of the cluster fixed hourly cost in proportion to the namespace requests cost.",
+	}, []string{"namespace"})
+	ClusterSharedHourlyCostMetric = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: metricsNamespace,
+		Subsystem: "cluster",
+		Name:      "shared_hourly_cost",
+		Help:      "Requests hourly cost of the platform pods selected by the shared cost rule and moved to the tenant namespaces.",
+	}, []string{"rule"})
+	NamespaceOwnHourlyCostMetric = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: metricsNamespace,
+		Subsystem: "namespace",
+		Name:      "own_hourly_cost",
+		Help:      "Requests hourly cost of the namespace pods.",
+	}, []string{"namespace"})
+	NamespaceWithSharedHourlyCostMetric = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: metricsNamespace,
+		Subsystem: "namespace",
+		Name:      "with_shared_hourly_cost",
+		Help:      "Requests hourly cost of the namespace pods with the shared costs moved from the platform namespaces.",
+	}, []string{"namespace"})
+
+	AWSPriceListPublishedAtMetric = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: metricsNamespace,
+		Subsystem: "aws_price_list",
+		Name:      "published_at_timestamp_seconds",
+		Help:      "Publication date of the loaded AWS bulk price list offer file.",
+	}, []string{"region", "file"})
 )
 
 // RegisterMetrics registers all metrics in the Metrics map with Prometheus's global registry.
 func RegisterMetrics() {
 	metrics.Registry.MustRegister(NodeHourlyCostMetric)
+	metrics.Registry.MustRegister(NodeStorageHourlyCostMetric)
+	metrics.Registry.MustRegister(NodeCorrectionFactorMetric)
+	metrics.Registry.MustRegister(NodeInvoicedHourlyCostMetric)
+	metrics.Registry.MustRegister(NodeIdleHourlyCostMetric)
+	metrics.Registry.MustRegister(NodeReservedHourlyCostMetric)
 	metrics.Registry.MustRegister(PodCPUHourlyCostMetric)
 	metrics.Registry.MustRegister(PodMemoryHourlyCostMetric)
 	metrics.Registry.MustRegister(PodRequestsHourlyCostMetric)
+	metrics.Registry.MustRegister(PodGPUHourlyCostMetric)
+	metrics.Registry.MustRegister(PodUsageHourlyCostMetric)
+	metrics.Registry.MustRegister(PodUsageCostMetric)
+	metrics.Registry.MustRegister(PodEffectiveHourlyCostMetric)
+	metrics.Registry.MustRegister(PodEffectiveCostMetric)
+	metrics.Registry.MustRegister(PodPendingEstimatedHourlyCostMetric)
+	metrics.Registry.MustRegister(ContainerRequestsHourlyCostMetric)
+	metrics.Registry.MustRegister(ContainerUsageHourlyCostMetric)
+	metrics.Registry.MustRegister(PVHourlyCostMetric)
+	metrics.Registry.MustRegister(CapacityReservationUnusedHourlyCostMetric)
+	metrics.Registry.MustRegister(ClusterFixedHourlyCostMetric)
+	metrics.Registry.MustRegister(NamespaceFixedHourlyCostMetric)
+	metrics.Registry.MustRegister(ClusterSharedHourlyCostMetric)
+	metrics.Registry.MustRegister(NamespaceOwnHourlyCostMetric)
+	metrics.Registry.MustRegister(NamespaceWithSharedHourlyCostMetric)
+	metrics.Registry.MustRegister(AWSPriceListPublishedAtMetric)
 }
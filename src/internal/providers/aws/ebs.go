@@ -0,0 +1,34 @@
+// Copyright 2025 The MoneyPod Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package aws
+
+// CSIDriver is the name of the EBS CSI driver provisioning the persistent volumes
+const CSIDriver = "ebs.csi.aws.com"
+
+// EBS prices are monthly, AWS bills 730 hours per month
+const hoursPerMonth = 730
+
+// EBS pricing product families
+const (
+	productFamilyStorage    = "Storage"
+	productFamilyIOPS       = "System Operation"
+	productFamilyThroughput = "Provisioned Throughput"
+)
+
+// Performance included into the gp3 volume price, only the excess is charged
+const (
+	gp3BaselineIOPS       = 3000
+	gp3BaselineThroughput = 125
+)
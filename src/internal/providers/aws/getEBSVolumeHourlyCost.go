@@ -0,0 +1,103 @@
+// Copyright 2025 The MoneyPod Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package aws
+
+import (
+	"context"
+	"strconv"
+
+	ec2Types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	pricingTypes "github.com/aws/aws-sdk-go-v2/service/pricing/types"
+	. "github.com/vlasov-y/moneypod/internal/utils"
+	"k8s.io/utils/ptr"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+// getEBSVolumeHourlyCost sums the size, provisioned IOPS and throughput prices of the volume.
+// Monthly prices are converted to hourly ones.
+func (provider *Provider) getEBSVolumeHourlyCost(ctx context.Context,
+	volume ec2Types.Volume) (hourlyCost float64, err error) {
+	log := logf.FromContext(ctx).WithValues("volume", ptr.Deref(volume.VolumeId, ""))
+	region := provider.getLocation(ctx, ptr.Deref(volume.AvailabilityZone, ""))
+	volumeType := string(volume.VolumeType)
+
+	// Monthly price of the single unit of the dimension
+	getMonthlyPrice := func(productFamily string) (price float64, err error) {
+		filters := []pricingTypes.Filter{
+			{
+				Field: ptr.To("productFamily"),
+				Value: ptr.To(productFamily),
+				Type:  pricingTypes.FilterTypeTermMatch,
+			},
+			{
+				Field: ptr.To("volumeApiName"),
+				Value: ptr.To(volumeType),
+				Type:  pricingTypes.FilterTypeTermMatch,
+			},
+			{
+				Field: ptr.To("regionCode"),
+				Value: ptr.To(region),
+				Type:  pricingTypes.FilterTypeTermMatch,
+			},
+		}
+		var priceStr string
+		var found bool
+		if priceStr, found, err = provider.getOnDemandPrice(ctx, region, "", filters); err != nil {
+			return
+		}
+		if !found {
+			log.Info("no pricing data found", "volumeType", volumeType, "productFamily", productFamily)
+			return price, ErrRequestRequeue
+		}
+		if price, err = strconv.ParseFloat(priceStr, 64); err != nil {
+			log.Error(err, "failed to parse the volume price", "price", priceStr)
+		}
+		return
+	}
+
+	// Provisioned size is charged for every volume type
+	var price float64
+	if price, err = getMonthlyPrice(productFamilyStorage); err != nil {
+		return
+	}
+	monthlyCost := price * float64(ptr.Deref(volume.Size, 0))
+
+	// Provisioned IOPS and throughput are charged separately
+	var iops, throughput int32
+	switch volume.VolumeType {
+	case ec2Types.VolumeTypeGp3:
+		iops = max(ptr.Deref(volume.Iops, 0)-gp3BaselineIOPS, 0)
+		throughput = max(ptr.Deref(volume.Throughput, 0)-gp3BaselineThroughput, 0)
+	case ec2Types.VolumeTypeIo1, ec2Types.VolumeTypeIo2:
+		iops = ptr.Deref(volume.Iops, 0)
+	}
+	if iops > 0 {
+		if price, err = getMonthlyPrice(productFamilyIOPS); err != nil {
+			return
+		}
+		monthlyCost += price * float64(iops)
+	}
+	if throughput > 0 {
+		if price, err = getMonthlyPrice(productFamilyThroughput); err != nil {
+			return
+		}
+		monthlyCost += price * float64(throughput)
+	}
+
+	hourlyCost = monthlyCost / hoursPerMonth
+	log.V(1).Info("volume hourly cost", "type", volumeType, "size", ptr.Deref(volume.Size, 0),
+		"iops", iops, "throughput", throughput, "hourlyCost", hourlyCost)
+	return
+}
@@ -0,0 +1,92 @@
+// Copyright 2025 The MoneyPod Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package aws
+
+import (
+	"os"
+	"path/filepath"
+
+	ec2Types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/vlasov-y/moneypod/internal/utils"
+	"k8s.io/utils/ptr"
+)
+
+const offerEBSJSON = `{
+  "publicationDate": "2025-09-30T18:15:36Z",
+  "products": {
+    "GP3": {"productFamily": "Storage", "attributes": {"volumeApiName": "gp3", "regionCode": "eu-central-1"}},
+    "GP3IOPS": {"productFamily": "System Operation", "attributes": {"volumeApiName": "gp3", "regionCode": "eu-central-1"}},
+    "GP3TP": {"productFamily": "Provisioned Throughput", "attributes": {"volumeApiName": "gp3", "regionCode": "eu-central-1"}},
+    "IO2": {"productFamily": "Storage", "attributes": {"volumeApiName": "io2", "regionCode": "eu-central-1"}},
+    "IO2IOPS": {"productFamily": "System Operation", "attributes": {"volumeApiName": "io2", "regionCode": "eu-central-1"}}
+  },
+  "terms": {
+    "OnDemand": {
+      "GP3": {"GP3.T": {"priceDimensions": {"GP3.T.D": {"unit": "GB-Mo", "pricePerUnit": {"USD": "0.0952"}}}}},
+      "GP3IOPS": {"GP3IOPS.T": {"priceDimensions": {"GP3IOPS.T.D": {"unit": "IOPS-Mo", "pricePerUnit": {"USD": "0.0058"}}}}},
+      "GP3TP": {"GP3TP.T": {"priceDimensions": {"GP3TP.T.D": {"unit": "GiBps-mo", "pricePerUnit": {"USD": "0.046"}}}}},
+      "IO2": {"IO2.T": {"priceDimensions": {"IO2.T.D": {"unit": "GB-Mo", "pricePerUnit": {"USD": "0.149"}}}}},
+      "IO2IOPS": {"IO2IOPS.T": {"priceDimensions": {"IO2IOPS.T.D": {"unit": "IOPS-Mo", "pricePerUnit": {"USD": "0.078"}}}}}
+    }
+  }
+}`
+
+var _ = Describe("getEBSVolumeHourlyCost", Ordered, func() {
+	var p *Provider
+
+	volume := func(volumeType ec2Types.VolumeType, size, iops, throughput int32) ec2Types.Volume {
+		return ec2Types.Volume{
+			VolumeId:         ptr.To("vol-0123456789"),
+			AvailabilityZone: ptr.To("eu-central-1a"),
+			VolumeType:       volumeType,
+			Size:             ptr.To(size),
+			Iops:             ptr.To(iops),
+			Throughput:       ptr.To(throughput),
+		}
+	}
+
+	BeforeAll(func() {
+		path := filepath.Join(GinkgoT().TempDir(), "ebs.json")
+		Expect(os.WriteFile(path, []byte(offerEBSJSON), 0o600)).To(Succeed())
+		pl, err := loadPriceList(ctx, path)
+		Expect(err).ToNot(HaveOccurred())
+		p = &Provider{priceList: pl}
+	})
+
+	It("should charge only the size of the gp3 volume with the baseline performance", func() {
+		hourlyCost, err := p.getEBSVolumeHourlyCost(ctx, volume(ec2Types.VolumeTypeGp3, 100, 3000, 125))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(hourlyCost).To(BeNumerically("~", 100*0.0952/730, 1e-9))
+	})
+
+	It("should charge the gp3 performance above the baseline", func() {
+		hourlyCost, err := p.getEBSVolumeHourlyCost(ctx, volume(ec2Types.VolumeTypeGp3, 100, 4000, 250))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(hourlyCost).To(BeNumerically("~", (100*0.0952+1000*0.0058+125*0.046)/730, 1e-9))
+	})
+
+	It("should charge all the provisioned IOPS of the io2 volume", func() {
+		hourlyCost, err := p.getEBSVolumeHourlyCost(ctx, volume(ec2Types.VolumeTypeIo2, 50, 1000, 0))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(hourlyCost).To(BeNumerically("~", (50*0.149+1000*0.078)/730, 1e-9))
+	})
+
+	It("should requeue if the volume type is not priced", func() {
+		_, err := p.getEBSVolumeHourlyCost(ctx, volume(ec2Types.VolumeTypeSt1, 500, 0, 0))
+		Expect(err).To(MatchError(ErrRequestRequeue))
+	})
+})
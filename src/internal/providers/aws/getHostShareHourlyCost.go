@@ -0,0 +1,106 @@
+// Copyright 2025 The MoneyPod Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package aws
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	ec2 "github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2Types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	pricingTypes "github.com/aws/aws-sdk-go-v2/service/pricing/types"
+	. "github.com/vlasov-y/moneypod/internal/utils"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/ptr"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+// getHostShareHourlyCost returns the part of the dedicated host price proportional to the instance vCPUs.
+// Configured rates are per instance type, so they are not used as a fallback for the host price.
+func (provider *Provider) getHostShareHourlyCost(ctx context.Context, r record.EventRecorder, node *corev1.Node,
+	instance ec2Types.Instance) (hourlyCost float64, err error) {
+	log := logf.FromContext(ctx)
+
+	// Describe the host
+	var describe *ec2.DescribeHostsOutput
+	if describe, err = provider.clientEc2.DescribeHosts(ctx, &ec2.DescribeHostsInput{
+		HostIds: []string{*instance.Placement.HostId},
+	}); err != nil {
+		log.Error(err, "failed to describe the dedicated host")
+		r.Eventf(node, corev1.EventTypeWarning, "DescribeHostFailed", err.Error())
+		return
+	}
+	if len(describe.Hosts) == 0 || describe.Hosts[0].HostProperties == nil {
+		log.Info("dedicated host is not found", "host", *instance.Placement.HostId)
+		return hourlyCost, ErrRequestRequeue
+	}
+	host := describe.Hosts[0]
+
+	// Host is priced per instance family
+	family := ptr.Deref(host.HostProperties.InstanceFamily, "")
+	if family == "" {
+		family = strings.Split(ptr.Deref(host.HostProperties.InstanceType, ""), ".")[0]
+	}
+	region := provider.getLocation(ctx, ptr.Deref(host.AvailabilityZone, *instance.Placement.AvailabilityZone))
+	filters := []pricingTypes.Filter{
+		{
+			Field: ptr.To("productFamily"),
+			Value: ptr.To("Dedicated Host"),
+			Type:  pricingTypes.FilterTypeTermMatch,
+		},
+		{
+			Field: ptr.To("instanceType"),
+			Value: ptr.To(family),
+			Type:  pricingTypes.FilterTypeTermMatch,
+		},
+		{
+			Field: ptr.To("regionCode"),
+			Value: ptr.To(region),
+			Type:  pricingTypes.FilterTypeTermMatch,
+		},
+	}
+
+	var priceStr string
+	var found bool
+	if priceStr, found, err = provider.getOnDemandPrice(ctx, region, "", filters); err != nil {
+		return
+	}
+	if !found {
+		log.Info("no pricing data found", "hostFamily", family)
+		return hourlyCost, ErrRequestRequeue
+	}
+	var hostHourlyCost float64
+	if hostHourlyCost, err = strconv.ParseFloat(priceStr, 64); err != nil || hostHourlyCost == 0 {
+		msg := fmt.Sprintf("failed to parse the dedicated host price or it is zero: %s", priceStr)
+		log.Error(err, msg)
+		return
+	}
+
+	// Split the host price between the instances by vCPUs
+	hourlyCost = hostHourlyCost
+	totalVCpus := ptr.Deref(host.HostProperties.TotalVCpus, 0)
+	if instance.CpuOptions != nil && totalVCpus > 0 {
+		vCpus := ptr.Deref(instance.CpuOptions.CoreCount, 0) * ptr.Deref(instance.CpuOptions.ThreadsPerCore, 1)
+		if vCpus > 0 {
+			hourlyCost = hostHourlyCost * float64(vCpus) / float64(totalVCpus)
+		}
+	}
+	log.V(1).Info("dedicated host price", "host", *host.HostId, "price", hostHourlyCost,
+		"totalVCpus", totalVCpus, "share", hourlyCost)
+	return
+}
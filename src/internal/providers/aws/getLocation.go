@@ -0,0 +1,77 @@
+// Copyright 2025 The MoneyPod Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package aws
+
+import (
+	"context"
+
+	ec2 "github.com/aws/aws-sdk-go-v2/service/ec2"
+	"k8s.io/utils/ptr"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+// Zone types returned by DescribeAvailabilityZones
+const (
+	zoneTypeLocalZone  = "local-zone"
+	zoneTypeWavelength = "wavelength-zone"
+)
+
+// getLocation returns the pricing region code of the availability zone.
+// Local Zones and Wavelength Zones are priced by their network border group, e.g. us-west-2-lax-1,
+// regular zones and Outposts anchored to them by the parent region.
+// Zones are described once, the zone name is parsed if it cannot be described.
+func (provider *Provider) getLocation(ctx context.Context, availabilityZone string) (location string) {
+	log := logf.FromContext(ctx)
+
+	provider.zonesMutex.RLock()
+	location, exists := provider.zones[availabilityZone]
+	provider.zonesMutex.RUnlock()
+	if exists {
+		return
+	}
+
+	location = getZoneGroup(availabilityZone)
+	if provider.clientEc2 == nil {
+		return
+	}
+
+	describe, err := provider.clientEc2.DescribeAvailabilityZones(ctx, &ec2.DescribeAvailabilityZonesInput{
+		ZoneNames:            []string{availabilityZone},
+		AllAvailabilityZones: ptr.To(true), // Local Zones and Wavelength Zones are opt-in
+	})
+	if err != nil {
+		// Not cached, so the zone is described again next time
+		log.Error(err, "failed to describe the availability zone, parsing the zone name", "zone", availabilityZone)
+		return
+	}
+	for _, zone := range describe.AvailabilityZones {
+		switch ptr.Deref(zone.ZoneType, "") {
+		case zoneTypeLocalZone, zoneTypeWavelength:
+			location = ptr.Deref(zone.NetworkBorderGroup, location)
+		default:
+			location = getRegion(availabilityZone)
+		}
+		log.V(1).Info("described the availability zone", "zone", availabilityZone,
+			"type", ptr.Deref(zone.ZoneType, ""), "location", location)
+	}
+
+	provider.zonesMutex.Lock()
+	if provider.zones == nil {
+		provider.zones = map[string]string{}
+	}
+	provider.zones[availabilityZone] = location
+	provider.zonesMutex.Unlock()
+	return
+}
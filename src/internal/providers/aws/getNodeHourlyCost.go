@@ -16,45 +16,38 @@ package aws
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 	"strconv"
 
-	"github.com/aws/aws-sdk-go-v2/aws"
-	"github.com/aws/aws-sdk-go-v2/config"
 	ec2 "github.com/aws/aws-sdk-go-v2/service/ec2"
-	pricing "github.com/aws/aws-sdk-go-v2/service/pricing"
-	pricingTypes "github.com/aws/aws-sdk-go-v2/service/pricing/types"
+	ec2Types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
 	. "github.com/vlasov-y/moneypod/internal/utils"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/client-go/tools/record"
-	"k8s.io/utils/ptr"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
 )
 
 func (provider *Provider) GetNodeHourlyCost(ctx context.Context, r record.EventRecorder, node *corev1.Node) (hourlyCost float64, err error) {
 	log := logf.FromContext(ctx)
 
-	// Get instanceID
-	var instanceID string
-	if instanceID, err = provider.getInstanceID(ctx, r, node); err != nil {
+	if provider.noCredentials {
+		return provider.getNodeHourlyCostFromLabels(ctx, r, node)
+	}
+	if provider.clientEc2 == nil {
+		err = ErrNotConfigured
+		log.Error(err, "AWS provider has to be created with NewProvider")
 		return
 	}
 
-	// Authorize AWS
-	var awsConfig aws.Config
-	if awsConfig, err = config.LoadDefaultConfig(ctx); err != nil {
-		log.Error(err, "failed to load AWS config")
+	// Get instanceID
+	var instanceID string
+	if instanceID, err = provider.getInstanceID(ctx, r, node); err != nil {
 		return
 	}
-	clientEc2 := ec2.NewFromConfig(awsConfig)
-	clientPricing := pricing.NewFromConfig(awsConfig, func(o *pricing.Options) {
-		o.Region = "us-east-1" // Pricing API only available in us-east-1
-	})
 
 	// Describe the instance
 	var describe *ec2.DescribeInstancesOutput
-	if describe, err = clientEc2.DescribeInstances(ctx, &ec2.DescribeInstancesInput{
+	if describe, err = provider.clientEc2.DescribeInstances(ctx, &ec2.DescribeInstancesInput{
 		InstanceIds: []string{instanceID},
 	}); err != nil {
 		log.Error(err, "failed to describe the instance")
@@ -70,7 +63,7 @@ func (provider *Provider) GetNodeHourlyCost(ctx context.Context, r record.EventR
 				log.V(1).Info("instance has a spot request")
 				// Get spot price from spot instance request
 				var spotResult *ec2.DescribeSpotInstanceRequestsOutput
-				if spotResult, err = clientEc2.DescribeSpotInstanceRequests(ctx, &ec2.DescribeSpotInstanceRequestsInput{
+				if spotResult, err = provider.clientEc2.DescribeSpotInstanceRequests(ctx, &ec2.DescribeSpotInstanceRequestsInput{
 					SpotInstanceRequestIds: []string{*instance.SpotInstanceRequestId},
 				}); err != nil {
 					log.Error(err, "failed to describe spot instance request")
@@ -94,99 +87,61 @@ func (provider *Provider) GetNodeHourlyCost(ctx context.Context, r record.EventR
 					// Spot instance request may not appear instantly, we will try again later
 					return hourlyCost, ErrRequestRequeue
 				}
+			} else if instance.Placement.Tenancy == ec2Types.TenancyHost && instance.Placement.HostId != nil {
+				log.V(1).Info("instance runs on a dedicated host", "host", *instance.Placement.HostId)
+				// Instance on the dedicated host costs nothing, the host is paid for instead
+				if hourlyCost, err = provider.getHostShareHourlyCost(ctx, r, node, instance); err != nil {
+					return
+				}
+				priceStr := strconv.FormatFloat(hourlyCost, 'f', -1, 64)
+				log.Info(fmt.Sprintf("dedicated host share price: %s", priceStr))
+				r.Eventf(node, corev1.EventTypeNormal, "HourlyCost", priceStr)
+			} else if instance.OutpostArn != nil {
+				log.V(1).Info("instance runs on an outpost", "outpost", *instance.OutpostArn)
+				// Cost stays unknown until the rate is configured
+				if hourlyCost = provider.getOutpostHourlyCost(ctx, r, node, instance); hourlyCost > 0 {
+					priceStr := strconv.FormatFloat(hourlyCost, 'f', -1, 64)
+					log.Info(fmt.Sprintf("outpost instance price: %s", priceStr))
+					r.Eventf(node, corev1.EventTypeNormal, "HourlyCost", priceStr)
+				}
+				// Outpost storage is prepaid along with the capacity
+				return
 			} else {
 				log.V(1).Info("instance has no spot request, treating as an on-demand")
 				// If instance is on-demand - get the price for instance type in the region
-				var priceResult *pricing.GetProductsOutput
-				region := (*instance.Placement.AvailabilityZone)[:len(*instance.Placement.AvailabilityZone)-1]
-				log.V(1).Info("instance region", "region", region)
-				pricingInput := &pricing.GetProductsInput{
-					ServiceCode: ptr.To("AmazonEC2"),
-					Filters: []pricingTypes.Filter{
-						{
-							Field: ptr.To("instanceType"),
-							Value: ptr.To(string(instance.InstanceType)),
-							Type:  pricingTypes.FilterTypeTermMatch,
-						},
-						{
-							Field: ptr.To("regionCode"),
-							Value: ptr.To(region),
-							Type:  pricingTypes.FilterTypeTermMatch,
-						},
-						{
-							Field: ptr.To("operatingSystem"),
-							Value: ptr.To(func() string {
-								if instance.Platform == "windows" {
-									return "Windows"
-								}
-								return "Linux"
-							}()),
-							Type: pricingTypes.FilterTypeTermMatch,
-						},
-						{
-							Field: ptr.To("capacitystatus"),
-							Value: ptr.To("Used"),
-							Type:  pricingTypes.FilterTypeTermMatch,
-						},
-						{
-							Field: ptr.To("preInstalledSw"),
-							Value: ptr.To("NA"),
-							Type:  pricingTypes.FilterTypeTermMatch,
-						},
-						{
-							Field: ptr.To("tenancy"),
-							Value: ptr.To("Shared"),
-							Type:  pricingTypes.FilterTypeTermMatch,
-						},
-					},
-				}
-				// Verbose filters log
-				var labels []any
-				for _, filter := range pricingInput.Filters {
-					labels = append(labels, *filter.Field, *filter.Value)
+				region := provider.getLocation(ctx, *instance.Placement.AvailabilityZone)
+				log.V(1).Info("instance region", "region", region, "partition", getPartition(region).name)
+
+				capacityStatus := capacityStatusUsed
+				if instance.CapacityReservationId != nil {
+					log.V(1).Info("instance runs in a capacity reservation", "reservation", *instance.CapacityReservationId)
+					capacityStatus = capacityStatusAllocatedReservation
 				}
-				log.V(1).Info("pricing request input filters", labels...)
 
-				// Querying pricing API
-				if priceResult, err = clientPricing.GetProducts(ctx, pricingInput); err != nil {
-					log.Error(err, "failed to get instance pricing")
+				var priceStr string
+				var found bool
+				if priceStr, found, err = provider.getOnDemandPrice(ctx, region, string(instance.InstanceType),
+					provider.getPricingFilters(instance, region, capacityStatus)); err != nil {
 					return
 				}
-
-				if len(priceResult.PriceList) > 0 {
-					log.V(1).Info("pricing list", "list", priceResult.PriceList[0])
-					var priceData map[string]interface{}
-					if err = json.Unmarshal([]byte(priceResult.PriceList[0]), &priceData); err != nil {
-						log.Error(err, "failed to parse pricing JSON")
-						return
-					}
-
-					terms := priceData["terms"].(map[string]interface{})
-					onDemand := terms["OnDemand"].(map[string]interface{})
-					for _, term := range onDemand {
-						termData := term.(map[string]interface{})
-						priceDimensions := termData["priceDimensions"].(map[string]interface{})
-						for _, dimension := range priceDimensions {
-							dimensionData := dimension.(map[string]interface{})
-							pricePerUnit := dimensionData["pricePerUnit"].(map[string]interface{})
-							priceStr := pricePerUnit["USD"].(string)
-							if hourlyCost, err = strconv.ParseFloat(priceStr, 64); err != nil || hourlyCost == 0 {
-								msg := fmt.Sprintf("failed to parse the on-demand price or it is zero: %s", priceStr)
-								log.Error(err, msg)
-								return
-							}
-							log.Info(fmt.Sprintf("on-demand instance price: %s", priceStr))
-							r.Eventf(node, corev1.EventTypeNormal, "HourlyCost", priceStr)
-							break
-						}
-						break
-					}
-				} else {
+				if !found {
 					msg := "no pricing data found"
 					log.Info(msg, "instanceType", string(instance.InstanceType))
 					return hourlyCost, ErrRequestRequeue
 				}
+				if hourlyCost, err = strconv.ParseFloat(priceStr, 64); err != nil || hourlyCost == 0 {
+					msg := fmt.Sprintf("failed to parse the on-demand price or it is zero: %s", priceStr)
+					log.Error(err, msg)
+					return
+				}
+				log.Info(fmt.Sprintf("on-demand instance price: %s", priceStr))
+				r.Eventf(node, corev1.EventTypeNormal, "HourlyCost", priceStr)
 			}
+
+			// Disks launched with the instance are a part of the node cost
+			storageHourlyCost := provider.getNodeVolumesHourlyCost(ctx, r, node, instance)
+			log.V(1).Info("node storage price", "price", storageHourlyCost)
+			hourlyCost += storageHourlyCost
 		}
 	}
 
@@ -0,0 +1,77 @@
+// Copyright 2025 The MoneyPod Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package aws
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/vlasov-y/moneypod/internal/types"
+	. "github.com/vlasov-y/moneypod/internal/utils"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/tools/record"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+// getNodeHourlyCostFromLabels prices the node using only its labels and the price list or rates, no AWS API is called
+func (provider *Provider) getNodeHourlyCostFromLabels(ctx context.Context, r record.EventRecorder,
+	node *corev1.Node) (hourlyCost float64, err error) {
+	log := logf.FromContext(ctx)
+
+	instance, complete := getInstanceFromLabels(node)
+	if !complete {
+		msg := fmt.Sprintf("%s and %s labels are required", corev1.LabelInstanceTypeStable, corev1.LabelTopologyZone)
+		log.Info(msg)
+		r.Eventf(node, corev1.EventTypeWarning, "NoInstanceLabels", msg)
+		return
+	}
+	instanceType := string(instance.InstanceType)
+	region := provider.getLocation(ctx, *instance.Placement.AvailabilityZone)
+	log.V(1).Info("instance from labels", "instanceType", instanceType, "region", region)
+
+	// Spot price is not published in the price list, only the configured rate can be used
+	var priceStr string
+	var found bool
+	if getCapacityFromLabels(node) == types.Spot {
+		var rate float64
+		if rate, found = provider.lookupRate(region, instanceType, string(types.Spot), ""); found {
+			priceStr = strconv.FormatFloat(rate, 'f', -1, 64)
+		} else {
+			log.Info("no spot rate found, using the on-demand price", "instanceType", instanceType)
+			r.Eventf(node, corev1.EventTypeWarning, "SpotPriceUnknown",
+				"no spot rate is configured for %s, using the on-demand price", instanceType)
+		}
+	}
+	if !found {
+		if priceStr, found, err = provider.getOnDemandPrice(ctx, region, instanceType,
+			provider.getPricingFilters(instance, region, capacityStatusUsed)); err != nil {
+			return
+		}
+	}
+	if !found {
+		log.Info("no pricing data found", "instanceType", instanceType)
+		return hourlyCost, ErrRequestRequeue
+	}
+
+	if hourlyCost, err = strconv.ParseFloat(priceStr, 64); err != nil || hourlyCost == 0 {
+		msg := fmt.Sprintf("failed to parse the price or it is zero: %s", priceStr)
+		log.Error(err, msg)
+		return
+	}
+	log.Info(fmt.Sprintf("instance price from labels: %s", priceStr))
+	r.Eventf(node, corev1.EventTypeNormal, "HourlyCost", priceStr)
+	return
+}
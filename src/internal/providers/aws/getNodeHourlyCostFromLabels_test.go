@@ -0,0 +1,127 @@
+// Copyright 2025 The MoneyPod Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package aws
+
+import (
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/vlasov-y/moneypod/internal/utils"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+)
+
+var _ = Describe("getNodeHourlyCostFromLabels", Ordered, func() {
+	var p *Provider
+	// Events are not checked, buffer is big enough not to block
+	r := record.NewFakeRecorder(100)
+
+	newNode := func(labels map[string]string) *corev1.Node {
+		return &corev1.Node{
+			ObjectMeta: metav1.ObjectMeta{Name: "test", Labels: labels},
+			Spec:       corev1.NodeSpec{ProviderID: "aws:///eu-central-1a/i-02634bb78e730ced1"},
+		}
+	}
+
+	BeforeAll(func() {
+		path := filepath.Join(GinkgoT().TempDir(), "rates.yaml")
+		Expect(os.WriteFile(path, []byte(`
+- region: eu-central-1
+  instanceType: m5.large
+  hourlyCost: 0.115
+- region: eu-central-1
+  instanceType: m5.large
+  capacity: spot
+  hourlyCost: 0.041
+- region: us-west-2-lax-1
+  instanceType: m5.large
+  hourlyCost: 0.138
+`), 0o600)).To(Succeed())
+		p, err = NewProvider(ctx, Options{RatesPath: path, NoCredentials: true})
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("should require price list or rates", func() {
+		_, err := NewProvider(ctx, Options{NoCredentials: true})
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("should price the on-demand node", func() {
+		node := newNode(map[string]string{
+			corev1.LabelInstanceTypeStable: "m5.large",
+			corev1.LabelTopologyZone:       "eu-central-1a",
+		})
+		hourlyCost, err := p.GetNodeHourlyCost(ctx, r, node)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(hourlyCost).To(Equal(0.115))
+
+		info, err := p.GetNodeInfo(ctx, r, node)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(info.ID).To(Equal("i-02634bb78e730ced1"))
+		Expect(info.Type).To(Equal("m5.large"))
+		Expect(info.AvailabilityZone).To(Equal("eu-central-1a"))
+		Expect(info.Capacity).To(Equal("on-demand"))
+		Expect(info.Currency).To(Equal("USD"))
+		Expect(info.Tenancy).To(Equal("default"))
+		Expect(info.License).To(Equal("Linux/UNIX"))
+	})
+
+	It("should price the Local Zone node with the zone group rate", func() {
+		node := newNode(map[string]string{
+			corev1.LabelInstanceTypeStable: "m5.large",
+			corev1.LabelTopologyZone:       "us-west-2-lax-1a",
+		})
+		hourlyCost, err := p.GetNodeHourlyCost(ctx, r, node)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(hourlyCost).To(Equal(0.138))
+	})
+
+	It("should price the spot node from Karpenter and EKS labels", func() {
+		for label, value := range map[string]string{
+			labelKarpenterCapacityType: "spot",
+			labelEKSCapacityType:       "SPOT",
+		} {
+			By(label)
+			node := newNode(map[string]string{
+				corev1.LabelInstanceTypeStable: "m5.large",
+				corev1.LabelTopologyZone:       "eu-central-1b",
+				label:                          value,
+			})
+			hourlyCost, err := p.GetNodeHourlyCost(ctx, r, node)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(hourlyCost).To(Equal(0.041))
+			info, err := p.GetNodeInfo(ctx, r, node)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(info.Capacity).To(Equal("spot"))
+		}
+	})
+
+	It("should requeue an unknown instance type", func() {
+		_, err := p.GetNodeHourlyCost(ctx, r, newNode(map[string]string{
+			corev1.LabelInstanceTypeStable: "c5.large",
+			corev1.LabelTopologyZone:       "eu-central-1a",
+		}))
+		Expect(err).To(MatchError(ErrRequestRequeue))
+	})
+
+	It("should leave the cost unknown without labels", func() {
+		hourlyCost, err := p.GetNodeHourlyCost(ctx, r, newNode(nil))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(hourlyCost).To(BeZero())
+	})
+})
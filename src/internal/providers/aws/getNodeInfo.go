@@ -17,8 +17,6 @@ package aws
 import (
 	"context"
 
-	"github.com/aws/aws-sdk-go-v2/aws"
-	"github.com/aws/aws-sdk-go-v2/config"
 	ec2 "github.com/aws/aws-sdk-go-v2/service/ec2"
 	"github.com/vlasov-y/moneypod/internal/types"
 	corev1 "k8s.io/api/core/v1"
@@ -29,6 +27,15 @@ import (
 func (provider *Provider) GetNodeInfo(ctx context.Context, r record.EventRecorder, node *corev1.Node) (info types.NodeInfo, err error) {
 	log := logf.FromContext(ctx)
 
+	if provider.noCredentials {
+		return provider.getNodeInfoFromLabels(ctx, r, node)
+	}
+	if provider.clientEc2 == nil {
+		err = ErrNotConfigured
+		log.Error(err, "AWS provider has to be created with NewProvider")
+		return
+	}
+
 	// Gather node information
 	var instanceID string
 	if instanceID, err = provider.getInstanceID(ctx, r, node); err != nil {
@@ -36,17 +43,9 @@ func (provider *Provider) GetNodeInfo(ctx context.Context, r record.EventRecorde
 	}
 	info.ID = instanceID
 
-	// Authorize AWS
-	var awsConfig aws.Config
-	if awsConfig, err = config.LoadDefaultConfig(ctx); err != nil {
-		log.Error(err, "failed to load AWS config")
-		return
-	}
-	clientEc2 := ec2.NewFromConfig(awsConfig)
-
 	// Describe the instance
 	var describe *ec2.DescribeInstancesOutput
-	if describe, err = clientEc2.DescribeInstances(ctx, &ec2.DescribeInstancesInput{
+	if describe, err = provider.clientEc2.DescribeInstances(ctx, &ec2.DescribeInstancesInput{
 		InstanceIds: []string{instanceID},
 	}); err != nil {
 		log.Error(err, "failed to describe the instance")
@@ -60,6 +59,9 @@ func (provider *Provider) GetNodeInfo(ctx context.Context, r record.EventRecorde
 			info.Capacity = string(types.OnDemand)
 			info.Type = string(instance.InstanceType)
 			info.AvailabilityZone = *instance.Placement.AvailabilityZone
+			info.Currency = getPartition(getRegion(info.AvailabilityZone)).currency
+			info.License = getLicenseName(instance)
+			info.Tenancy = string(instance.Placement.Tenancy)
 			if instance.SpotInstanceRequestId != nil {
 				info.Capacity = string(types.Spot)
 			}
@@ -0,0 +1,41 @@
+// Copyright 2025 The MoneyPod Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package aws
+
+import (
+	"context"
+
+	"github.com/vlasov-y/moneypod/internal/types"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/tools/record"
+)
+
+// getNodeInfoFromLabels describes the node using only its labels, no AWS API is called
+func (provider *Provider) getNodeInfoFromLabels(ctx context.Context, r record.EventRecorder,
+	node *corev1.Node) (info types.NodeInfo, err error) {
+	if info.ID, err = provider.getInstanceID(ctx, r, node); err != nil {
+		return
+	}
+
+	instance, _ := getInstanceFromLabels(node)
+	info.Type = string(instance.InstanceType)
+	info.AvailabilityZone = *instance.Placement.AvailabilityZone
+	info.Capacity = string(getCapacityFromLabels(node))
+	info.Currency = getPartition(getRegion(info.AvailabilityZone)).currency
+	info.License = getLicenseName(instance)
+	// Dedicated tenancy cannot be told from the labels
+	info.Tenancy = string(instance.Placement.Tenancy)
+	return
+}
@@ -0,0 +1,73 @@
+// Copyright 2025 The MoneyPod Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package aws
+
+import (
+	"context"
+	"strconv"
+
+	ec2 "github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2Types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/vlasov-y/moneypod/internal/types"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/ptr"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+// getNodeVolumesHourlyCost returns the cost of the volumes launched with the instance, e.g. the root disk.
+// Volumes attached later (persistent volumes) are not deleted on termination, so they are skipped.
+// Failures are only logged, since the instance price is known anyway.
+func (provider *Provider) getNodeVolumesHourlyCost(ctx context.Context, r record.EventRecorder, node *corev1.Node,
+	instance ec2Types.Instance) (hourlyCost float64) {
+	log := logf.FromContext(ctx)
+
+	var volumeIDs []string
+	for _, mapping := range instance.BlockDeviceMappings {
+		if mapping.Ebs != nil && mapping.Ebs.VolumeId != nil && ptr.Deref(mapping.Ebs.DeleteOnTermination, false) {
+			volumeIDs = append(volumeIDs, *mapping.Ebs.VolumeId)
+		}
+	}
+	if len(volumeIDs) == 0 {
+		// Node has no disks of its own anymore, the previously priced storage is not charged
+		delete(node.Annotations, types.AnnotationNodeStorageHourlyCost)
+		return
+	}
+
+	var describe *ec2.DescribeVolumesOutput
+	var err error
+	if describe, err = provider.clientEc2.DescribeVolumes(ctx, &ec2.DescribeVolumesInput{
+		VolumeIds: volumeIDs,
+	}); err != nil {
+		log.Error(err, "failed to describe the node volumes")
+		r.Eventf(node, corev1.EventTypeWarning, "DescribeVolumesFailed", err.Error())
+		return
+	}
+
+	for _, volume := range describe.Volumes {
+		var volumeHourlyCost float64
+		if volumeHourlyCost, err = provider.getEBSVolumeHourlyCost(ctx, volume); err != nil {
+			log.Info("volume cost is unknown, skipping", "volume", ptr.Deref(volume.VolumeId, ""))
+			continue
+		}
+		hourlyCost += volumeHourlyCost
+	}
+
+	// Ephemeral storage of the pods is priced from the disks cost
+	metav1.SetMetaDataAnnotation(&node.ObjectMeta, types.AnnotationNodeStorageHourlyCost,
+		strconv.FormatFloat(hourlyCost, 'f', 10, 64))
+	return
+}
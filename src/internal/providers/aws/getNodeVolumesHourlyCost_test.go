@@ -0,0 +1,41 @@
+// Copyright 2025 The MoneyPod Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package aws
+
+import (
+	ec2Types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/vlasov-y/moneypod/internal/types"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/ptr"
+)
+
+var _ = Describe("getNodeVolumesHourlyCost", func() {
+	It("should clear the storage cost of the node without own volumes", func() {
+		node := &corev1.Node{ObjectMeta: metav1.ObjectMeta{
+			Annotations: map[string]string{AnnotationNodeStorageHourlyCost: "0.01"},
+		}}
+		// Attached later, kept on termination
+		instance := ec2Types.Instance{BlockDeviceMappings: []ec2Types.InstanceBlockDeviceMapping{{
+			Ebs: &ec2Types.EbsInstanceBlockDevice{VolumeId: ptr.To("vol-1"), DeleteOnTermination: ptr.To(false)},
+		}}}
+		p := Provider{}
+		Expect(p.getNodeVolumesHourlyCost(ctx, record.NewFakeRecorder(10), node, instance)).To(BeZero())
+		Expect(node.Annotations).NotTo(HaveKey(AnnotationNodeStorageHourlyCost))
+	})
+})
@@ -0,0 +1,72 @@
+// Copyright 2025 The MoneyPod Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package aws
+
+import (
+	"context"
+	"strconv"
+
+	pricing "github.com/aws/aws-sdk-go-v2/service/pricing"
+	pricingTypes "github.com/aws/aws-sdk-go-v2/service/pricing/types"
+	"github.com/vlasov-y/moneypod/internal/types"
+	"k8s.io/utils/ptr"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+// getOnDemandPrice looks the product up in the price list or in the Pricing API,
+// falling back to the rate configured for the region and instance type
+func (provider *Provider) getOnDemandPrice(ctx context.Context, region string, instanceType string,
+	filters []pricingTypes.Filter) (priceStr string, found bool, err error) {
+	log := logf.FromContext(ctx)
+	partition := getPartition(region)
+
+	pricingInput := &pricing.GetProductsInput{
+		ServiceCode: ptr.To("AmazonEC2"),
+		Filters:     filters,
+	}
+	// Verbose filters log
+	var labels []any
+	for _, filter := range pricingInput.Filters {
+		labels = append(labels, *filter.Field, *filter.Value)
+	}
+	log.V(1).Info("pricing request input filters", labels...)
+
+	if provider.priceList != nil {
+		// Looking up the bulk price list instead of querying the API
+		var product *priceListProduct
+		// Products priced in another currency are not comparable with the partition ones
+		if product, found = provider.priceList.lookup(pricingInput.Filters); found && product.currency == partition.currency {
+			priceStr = product.price
+		} else {
+			found = false
+		}
+	} else if provider.clientsPricing[partition.pricingRegion] != nil {
+		if priceStr, found, err = provider.getProductsPrice(ctx, partition, pricingInput); err != nil {
+			return
+		}
+	} else {
+		log.V(1).Info("partition has no Pricing API", "partition", partition.name)
+	}
+
+	// Fallback to the configured rates
+	if !found && instanceType != "" {
+		var rate float64
+		if rate, found = provider.lookupRate(region, instanceType, string(types.OnDemand), ""); found {
+			log.V(1).Info("using the configured rate", "rate", rate)
+			priceStr = strconv.FormatFloat(rate, 'f', -1, 64)
+		}
+	}
+	return
+}
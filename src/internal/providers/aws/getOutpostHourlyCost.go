@@ -0,0 +1,46 @@
+// Copyright 2025 The MoneyPod Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package aws
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	ec2Types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/vlasov-y/moneypod/internal/types"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/tools/record"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+// getOutpostHourlyCost returns the configured rate of the instance on the outpost.
+// Outpost capacity is prepaid, so there is no on-demand price to look up.
+func (provider *Provider) getOutpostHourlyCost(ctx context.Context, r record.EventRecorder, node *corev1.Node,
+	instance ec2Types.Instance) (hourlyCost float64) {
+	log := logf.FromContext(ctx)
+
+	// arn:aws:outposts:us-west-2:123456789012:outpost/op-0123456789abcdef0
+	outpostID := (*instance.OutpostArn)[strings.LastIndex(*instance.OutpostArn, "/")+1:]
+	region := getRegion(*instance.Placement.AvailabilityZone)
+
+	var found bool
+	if hourlyCost, found = provider.lookupRate(region, string(instance.InstanceType), string(types.OnDemand), outpostID); !found {
+		msg := fmt.Sprintf("no rate is configured for the outpost %s", outpostID)
+		log.Info(msg, "instanceType", string(instance.InstanceType))
+		r.Eventf(node, corev1.EventTypeWarning, "OutpostRateUnknown", msg)
+	}
+	return
+}
@@ -0,0 +1,47 @@
+// Copyright 2025 The MoneyPod Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package aws
+
+import (
+	ec2Types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/ptr"
+)
+
+var _ = Describe("getOutpostHourlyCost", func() {
+	instance := ec2Types.Instance{
+		InstanceType: ec2Types.InstanceTypeM5Large,
+		OutpostArn:   ptr.To("arn:aws:outposts:us-west-2:123456789012:outpost/op-0123456789abcdef0"),
+		Placement:    &ec2Types.Placement{AvailabilityZone: ptr.To("us-west-2a")},
+	}
+
+	It("should use the outpost rate", func() {
+		p := Provider{rates: []rate{
+			{Region: "us-west-2", HourlyCost: 0.1},
+			{Outpost: "op-0123456789abcdef0", InstanceType: "m5.large", HourlyCost: 0.05},
+		}}
+		Expect(p.getOutpostHourlyCost(ctx, record.NewFakeRecorder(10), &corev1.Node{}, instance)).To(Equal(0.05))
+	})
+
+	It("should warn if no rate is configured", func() {
+		r := record.NewFakeRecorder(10)
+		p := Provider{rates: []rate{{Outpost: "op-other", HourlyCost: 0.05}}}
+		Expect(p.getOutpostHourlyCost(ctx, r, &corev1.Node{}, instance)).To(BeZero())
+		Expect(r.Events).To(Receive(ContainSubstring("OutpostRateUnknown")))
+	})
+})
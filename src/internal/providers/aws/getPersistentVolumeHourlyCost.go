@@ -0,0 +1,71 @@
+// Copyright 2025 The MoneyPod Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package aws
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	ec2 "github.com/aws/aws-sdk-go-v2/service/ec2"
+	. "github.com/vlasov-y/moneypod/internal/utils"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/tools/record"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+func (provider *Provider) GetPersistentVolumeHourlyCost(ctx context.Context, r record.EventRecorder,
+	pv *corev1.PersistentVolume) (hourlyCost float64, err error) {
+	log := logf.FromContext(ctx)
+
+	if pv.Spec.CSI == nil || pv.Spec.CSI.Driver != CSIDriver {
+		err = fmt.Errorf("persistent volume is not provisioned by %s", CSIDriver)
+		log.Error(err, "unsupported persistent volume")
+		return
+	}
+	// Volume cannot be described without credentials, so its cost stays unknown
+	if provider.noCredentials {
+		log.V(1).Info("persistent volume cost is not supported without AWS credentials")
+		return
+	}
+	if provider.clientEc2 == nil {
+		err = ErrNotConfigured
+		log.Error(err, "AWS provider has to be created with NewProvider")
+		return
+	}
+
+	// Volume handle is the EBS volume ID
+	volumeID := pv.Spec.CSI.VolumeHandle
+
+	// Describe the volume
+	var describe *ec2.DescribeVolumesOutput
+	if describe, err = provider.clientEc2.DescribeVolumes(ctx, &ec2.DescribeVolumesInput{
+		VolumeIds: []string{volumeID},
+	}); err != nil {
+		log.Error(err, "failed to describe the volume", "volume", volumeID)
+		r.Eventf(pv, corev1.EventTypeWarning, "DescribeVolumeFailed", err.Error())
+		return
+	}
+	if len(describe.Volumes) == 0 {
+		log.Info("volume is not found", "volume", volumeID)
+		return hourlyCost, ErrRequestRequeue
+	}
+
+	if hourlyCost, err = provider.getEBSVolumeHourlyCost(ctx, describe.Volumes[0]); err != nil {
+		return
+	}
+	r.Eventf(pv, corev1.EventTypeNormal, "HourlyCost", strconv.FormatFloat(hourlyCost, 'f', -1, 64))
+	return
+}
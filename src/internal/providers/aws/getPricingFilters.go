@@ -0,0 +1,73 @@
+// Copyright 2025 The MoneyPod Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package aws
+
+import (
+	ec2Types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	pricingTypes "github.com/aws/aws-sdk-go-v2/service/pricing/types"
+	"k8s.io/utils/ptr"
+)
+
+// Capacity status pricing terms
+const (
+	// Regular on-demand instance
+	capacityStatusUsed = "Used"
+	// Instance running in the On-Demand Capacity Reservation
+	capacityStatusAllocatedReservation = "AllocatedCapacityReservation"
+	// Reserved capacity nothing runs in
+	capacityStatusUnusedReservation = "UnusedCapacityReservation"
+)
+
+// Pricing tenancy per instance placement tenancy
+var tenancies = map[ec2Types.Tenancy]string{
+	ec2Types.TenancyDefault:   "Shared",
+	ec2Types.TenancyDedicated: "Dedicated",
+	ec2Types.TenancyHost:      "Host",
+}
+
+// getPricingFilters returns product filters for the on-demand instance.
+// The same filters are used for the Pricing API and for the bulk price list lookup.
+func (*Provider) getPricingFilters(instance ec2Types.Instance, region string, capacityStatus string) (filters []pricingTypes.Filter) {
+	l, known := getLicense(instance)
+	tenancy := tenancies[ec2Types.TenancyDefault]
+	if instance.Placement != nil && tenancies[instance.Placement.Tenancy] != "" {
+		tenancy = tenancies[instance.Placement.Tenancy]
+	}
+
+	terms := []struct{ field, value string }{
+		{"instanceType", string(instance.InstanceType)},
+		{"regionCode", region},
+		{"operatingSystem", l.operatingSystem},
+		{"capacitystatus", capacityStatus},
+		{"preInstalledSw", l.preInstalledSw},
+		{"tenancy", tenancy},
+	}
+	// Usage operation identifies operating system, license model and pre-installed software exactly
+	if known {
+		terms = append(terms,
+			struct{ field, value string }{"licenseModel", l.licenseModel},
+			struct{ field, value string }{"operation", *instance.UsageOperation},
+		)
+	}
+
+	for _, term := range terms {
+		filters = append(filters, pricingTypes.Filter{
+			Field: ptr.To(term.field),
+			Value: ptr.To(term.value),
+			Type:  pricingTypes.FilterTypeTermMatch,
+		})
+	}
+	return
+}
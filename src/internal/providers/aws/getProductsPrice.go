@@ -0,0 +1,61 @@
+// Copyright 2025 The MoneyPod Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package aws
+
+import (
+	"context"
+	"encoding/json"
+
+	pricing "github.com/aws/aws-sdk-go-v2/service/pricing"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+// getProductsPrice queries the Pricing API and returns the on-demand price of the first matching product
+func (provider *Provider) getProductsPrice(ctx context.Context, partition partition,
+	pricingInput *pricing.GetProductsInput) (priceStr string, found bool, err error) {
+	log := logf.FromContext(ctx)
+
+	// Querying pricing API
+	var priceResult *pricing.GetProductsOutput
+	if priceResult, err = provider.clientsPricing[partition.pricingRegion].GetProducts(ctx, pricingInput); err != nil {
+		log.Error(err, "failed to get instance pricing")
+		return
+	}
+	if len(priceResult.PriceList) == 0 {
+		return
+	}
+
+	log.V(1).Info("pricing list", "list", priceResult.PriceList[0])
+	var priceData map[string]interface{}
+	if err = json.Unmarshal([]byte(priceResult.PriceList[0]), &priceData); err != nil {
+		log.Error(err, "failed to parse pricing JSON")
+		return
+	}
+
+	terms := priceData["terms"].(map[string]interface{})
+	onDemand := terms["OnDemand"].(map[string]interface{})
+	for _, term := range onDemand {
+		termData := term.(map[string]interface{})
+		priceDimensions := termData["priceDimensions"].(map[string]interface{})
+		for _, dimension := range priceDimensions {
+			dimensionData := dimension.(map[string]interface{})
+			pricePerUnit := dimensionData["pricePerUnit"].(map[string]interface{})
+			priceStr, found = pricePerUnit[partition.currency].(string)
+			break
+		}
+		break
+	}
+	return
+}
@@ -0,0 +1,60 @@
+// Copyright 2025 The MoneyPod Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package aws
+
+import (
+	"strings"
+
+	ec2Types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/vlasov-y/moneypod/internal/types"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/utils/ptr"
+)
+
+// Capacity type labels set by the node provisioners
+const (
+	// Karpenter: on-demand, spot or reserved
+	labelKarpenterCapacityType = "karpenter.sh/capacity-type"
+	// EKS managed node groups: ON_DEMAND, SPOT or CAPACITY_BLOCK
+	labelEKSCapacityType = "eks.amazonaws.com/capacityType"
+)
+
+// getCapacityFromLabels returns spot or on-demand, nodes without the labels are treated as on-demand
+func getCapacityFromLabels(node *corev1.Node) (capacity types.NodeCapacity) {
+	labels := node.GetLabels()
+	for _, label := range []string{labelKarpenterCapacityType, labelEKSCapacityType} {
+		if strings.EqualFold(labels[label], string(types.Spot)) {
+			return types.Spot
+		}
+	}
+	return types.OnDemand
+}
+
+// getInstanceFromLabels builds the instance description from the well-known node labels
+func getInstanceFromLabels(node *corev1.Node) (instance ec2Types.Instance, complete bool) {
+	labels := node.GetLabels()
+	instance = ec2Types.Instance{
+		InstanceType: ec2Types.InstanceType(labels[corev1.LabelInstanceTypeStable]),
+		Placement: &ec2Types.Placement{
+			AvailabilityZone: ptr.To(labels[corev1.LabelTopologyZone]),
+			Tenancy:          ec2Types.TenancyDefault,
+		},
+	}
+	if labels[corev1.LabelOSStable] == string(corev1.Windows) {
+		instance.Platform = ec2Types.PlatformValuesWindows
+	}
+	complete = instance.InstanceType != "" && *instance.Placement.AvailabilityZone != ""
+	return
+}
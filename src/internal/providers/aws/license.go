@@ -0,0 +1,107 @@
+// Copyright 2025 The MoneyPod Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package aws
+
+import (
+	"strings"
+
+	ec2Types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+)
+
+// license are the pricing terms of the instance operating system and pre-installed software.
+type license struct {
+	operatingSystem string
+	licenseModel    string
+	preInstalledSw  string
+}
+
+const (
+	licenseIncluded = "No License required"
+	licenseBYOL     = "Bring your own license"
+)
+
+// Pricing terms per instance UsageOperation, see
+// https://docs.aws.amazon.com/AWSEC2/latest/UserGuide/billing-info-fields.html
+var licenses = map[string]license{
+	"RunInstances":      {"Linux", licenseIncluded, "NA"},
+	"RunInstances:0002": {"Windows", licenseIncluded, "NA"},
+	"RunInstances:0800": {"Windows", licenseBYOL, "NA"},
+	"RunInstances:0006": {"Windows", licenseIncluded, "SQL Std"},
+	"RunInstances:0102": {"Windows", licenseIncluded, "SQL Ent"},
+	"RunInstances:0202": {"Windows", licenseIncluded, "SQL Web"},
+	"RunInstances:0004": {"Linux", licenseIncluded, "SQL Std"},
+	"RunInstances:0100": {"Linux", licenseIncluded, "SQL Ent"},
+	"RunInstances:0200": {"Linux", licenseIncluded, "SQL Web"},
+	"RunInstances:0010": {"RHEL", licenseIncluded, "NA"},
+	"RunInstances:0014": {"RHEL", licenseIncluded, "SQL Std"},
+	"RunInstances:0110": {"RHEL", licenseIncluded, "SQL Ent"},
+	"RunInstances:0210": {"RHEL", licenseIncluded, "SQL Web"},
+	"RunInstances:1010": {"Red Hat Enterprise Linux with HA", licenseIncluded, "NA"},
+	"RunInstances:1014": {"Red Hat Enterprise Linux with HA", licenseIncluded, "SQL Std"},
+	"RunInstances:1110": {"Red Hat Enterprise Linux with HA", licenseIncluded, "SQL Ent"},
+	"RunInstances:000g": {"SUSE", licenseIncluded, "NA"},
+	"RunInstances:0g00": {"Ubuntu Pro", licenseIncluded, "NA"},
+}
+
+// Usage operation per capacity reservation platform
+var reservationUsageOperations = map[ec2Types.CapacityReservationInstancePlatform]string{
+	ec2Types.CapacityReservationInstancePlatformLinuxUnix:                        "RunInstances",
+	ec2Types.CapacityReservationInstancePlatformRedHatEnterpriseLinux:            "RunInstances:0010",
+	ec2Types.CapacityReservationInstancePlatformSuseLinux:                        "RunInstances:000g",
+	ec2Types.CapacityReservationInstancePlatformWindows:                          "RunInstances:0002",
+	ec2Types.CapacityReservationInstancePlatformWindowsWithSqlServer:             "RunInstances:0006",
+	ec2Types.CapacityReservationInstancePlatformWindowsWithSqlServerEnterprise:   "RunInstances:0102",
+	ec2Types.CapacityReservationInstancePlatformWindowsWithSqlServerStandard:     "RunInstances:0006",
+	ec2Types.CapacityReservationInstancePlatformWindowsWithSqlServerWeb:          "RunInstances:0202",
+	ec2Types.CapacityReservationInstancePlatformLinuxWithSqlServerStandard:       "RunInstances:0004",
+	ec2Types.CapacityReservationInstancePlatformLinuxWithSqlServerWeb:            "RunInstances:0200",
+	ec2Types.CapacityReservationInstancePlatformLinuxWithSqlServerEnterprise:     "RunInstances:0100",
+	ec2Types.CapacityReservationInstancePlatformRhelWithSqlServerStandard:        "RunInstances:0014",
+	ec2Types.CapacityReservationInstancePlatformRhelWithSqlServerEnterprise:      "RunInstances:0110",
+	ec2Types.CapacityReservationInstancePlatformRhelWithSqlServerWeb:             "RunInstances:0210",
+	ec2Types.CapacityReservationInstancePlatformRhelWithHa:                       "RunInstances:1010",
+	ec2Types.CapacityReservationInstancePlatformRhelWithHaAndSqlServerStandard:   "RunInstances:1014",
+	ec2Types.CapacityReservationInstancePlatformRhelWithHaAndSqlServerEnterprise: "RunInstances:1110",
+	ec2Types.CapacityReservationInstancePlatformUbuntuProLinux:                   "RunInstances:0g00",
+}
+
+// getLicense returns the pricing terms of the instance, falling back to the platform if usage operation is unknown
+func getLicense(instance ec2Types.Instance) (l license, known bool) {
+	if instance.UsageOperation != nil {
+		if l, known = licenses[*instance.UsageOperation]; known {
+			return
+		}
+	}
+	if isWindows(instance) {
+		return licenses["RunInstances:0002"], false
+	}
+	return licenses["RunInstances"], false
+}
+
+// getLicenseName returns human readable name of the instance platform, e.g. "Red Hat Enterprise Linux"
+func getLicenseName(instance ec2Types.Instance) string {
+	if instance.PlatformDetails != nil && *instance.PlatformDetails != "" {
+		return *instance.PlatformDetails
+	}
+	if isWindows(instance) {
+		return "Windows"
+	}
+	return "Linux/UNIX"
+}
+
+// isWindows checks the platform, API returns it in lower case unlike the SDK enum
+func isWindows(instance ec2Types.Instance) bool {
+	return strings.EqualFold(string(instance.Platform), string(ec2Types.PlatformValuesWindows))
+}
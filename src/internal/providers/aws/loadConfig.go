@@ -0,0 +1,66 @@
+// Copyright 2025 The MoneyPod Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/retry"
+	"github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/credentials/stscreds"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+// LoadConfig loads the shared config and credentials and applies the retryer and the role to assume,
+// so every AWS client of the operator uses the same identity. Extra load options, e.g. the region, go last.
+func LoadConfig(ctx context.Context, opts Options, optFns ...func(*config.LoadOptions) error) (awsConfig aws.Config,
+	err error) {
+	log := logf.FromContext(ctx)
+
+	if awsConfig, err = config.LoadDefaultConfig(ctx, append([]func(*config.LoadOptions) error{
+		config.WithRetryer(func() aws.Retryer {
+			var retryer aws.Retryer = retry.NewStandard()
+			if opts.MaxAttempts > 0 {
+				retryer = retry.AddWithMaxAttempts(retryer, opts.MaxAttempts)
+			}
+			if opts.MaxBackoff > 0 {
+				retryer = retry.AddWithMaxBackoffDelay(retryer, opts.MaxBackoff)
+			}
+			return retryer
+		}),
+	}, optFns...)...); err != nil {
+		log.Error(err, "failed to load AWS config")
+		return
+	}
+
+	// Credentials of the role are refreshed before they expire
+	if opts.RoleARN != "" {
+		log.Info("assuming the AWS role", "role", opts.RoleARN)
+		clientSts := sts.NewFromConfig(awsConfig, func(o *sts.Options) {
+			if opts.STSEndpoint != "" {
+				o.BaseEndpoint = aws.String(opts.STSEndpoint)
+			}
+		})
+		awsConfig.Credentials = aws.NewCredentialsCache(stscreds.NewAssumeRoleProvider(clientSts, opts.RoleARN,
+			func(o *stscreds.AssumeRoleOptions) {
+				if opts.ExternalID != "" {
+					o.ExternalID = aws.String(opts.ExternalID)
+				}
+			}))
+	}
+	return
+}
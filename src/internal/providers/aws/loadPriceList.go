@@ -0,0 +1,395 @@
+// Copyright 2025 The MoneyPod Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package aws
+
+import (
+	"compress/gzip"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"maps"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/vlasov-y/moneypod/internal/monitoring"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+// loadPriceList reads the EC2 bulk price list offer files (JSON or CSV, optionally gzipped).
+// Path can be a single file or a directory with offer files, one per region.
+func loadPriceList(ctx context.Context, path string) (pl *priceList, err error) {
+	log := logf.FromContext(ctx)
+
+	var stat os.FileInfo
+	if stat, err = os.Stat(path); err != nil {
+		log.Error(err, "failed to open the price list", "path", path)
+		return
+	}
+	files := []string{path}
+	if stat.IsDir() {
+		files = []string{}
+		var entries []os.DirEntry
+		if entries, err = os.ReadDir(path); err != nil {
+			log.Error(err, "failed to read the price list directory", "path", path)
+			return
+		}
+		for _, entry := range entries {
+			name := strings.TrimSuffix(entry.Name(), ".gz")
+			if !entry.IsDir() && (strings.HasSuffix(name, ".json") || strings.HasSuffix(name, ".csv")) {
+				files = append(files, filepath.Join(path, entry.Name()))
+			}
+		}
+	}
+
+	pl = newPriceList()
+	for _, file := range files {
+		log.Info("loading the price list offer file", "file", file)
+		var regions []string
+		var publishedAt time.Time
+		if regions, publishedAt, err = pl.loadOfferFile(file); err != nil {
+			log.Error(err, "failed to load the price list offer file", "file", file)
+			return
+		}
+		for _, region := range regions {
+			monitoring.AWSPriceListPublishedAtMetric.WithLabelValues(region, filepath.Base(file)).
+				Set(float64(publishedAt.Unix()))
+		}
+		log.Info("loaded the price list offer file", "file", file, "regions", regions, "publishedAt", publishedAt)
+	}
+
+	if len(pl.products) == 0 {
+		err = fmt.Errorf("no products found in the price list %s", path)
+		log.Error(err, "price list is empty")
+		return
+	}
+	return
+}
+
+// loadOfferFile adds products from the offer file and returns the regions it covers with the publication date
+func (pl *priceList) loadOfferFile(file string) (regions []string, publishedAt time.Time, err error) {
+	var f *os.File
+	if f, err = os.Open(file); err != nil {
+		return
+	}
+	defer f.Close()
+
+	var reader io.Reader = f
+	name := file
+	if strings.HasSuffix(name, ".gz") {
+		var gz *gzip.Reader
+		if gz, err = gzip.NewReader(f); err != nil {
+			return
+		}
+		defer gz.Close()
+		reader = gz
+		name = strings.TrimSuffix(name, ".gz")
+	}
+
+	var products []*priceListProduct
+	if strings.HasSuffix(name, ".csv") {
+		products, publishedAt, err = parseOfferCSV(reader)
+	} else {
+		products, publishedAt, err = parseOfferJSON(reader)
+	}
+	if err != nil {
+		return
+	}
+
+	seen := map[string]bool{}
+	for _, product := range products {
+		pl.add(product)
+		if region := product.attributes[normalizeAttribute("regionCode")]; region != "" && !seen[region] {
+			seen[region] = true
+			regions = append(regions, region)
+		}
+	}
+	return
+}
+
+// parseOfferJSON streams the JSON offer file, so multi-gigabyte files do not have to fit into memory
+func parseOfferJSON(reader io.Reader) (products []*priceListProduct, publishedAt time.Time, err error) {
+	dec := json.NewDecoder(reader)
+	bySKU := map[string]*priceListProduct{}
+	type price struct {
+		value     string
+		currency  string
+		firstTier bool
+	}
+	prices := map[string]price{}
+
+	if err = expectJSONDelim(dec, '{'); err != nil {
+		return
+	}
+	for dec.More() {
+		var key string
+		if key, err = readJSONKey(dec); err != nil {
+			return
+		}
+		switch key {
+		case "publicationDate":
+			var value string
+			if err = dec.Decode(&value); err != nil {
+				return
+			}
+			if publishedAt, err = time.Parse(time.RFC3339, value); err != nil {
+				return
+			}
+
+		case "products":
+			if err = expectJSONDelim(dec, '{'); err != nil {
+				return
+			}
+			for dec.More() {
+				var sku string
+				if sku, err = readJSONKey(dec); err != nil {
+					return
+				}
+				var product struct {
+					ProductFamily string            `json:"productFamily"`
+					Attributes    map[string]string `json:"attributes"`
+				}
+				if err = dec.Decode(&product); err != nil {
+					return
+				}
+				if !isPriceListProductFamily(product.ProductFamily) {
+					continue
+				}
+				attributes := map[string]string{normalizeAttribute("productFamily"): product.ProductFamily}
+				for name, value := range product.Attributes {
+					if name = normalizeAttribute(name); isPriceListAttribute(name) {
+						attributes[name] = value
+					}
+				}
+				bySKU[sku] = &priceListProduct{attributes: attributes}
+			}
+			if err = expectJSONDelim(dec, '}'); err != nil {
+				return
+			}
+
+		case "terms":
+			if err = expectJSONDelim(dec, '{'); err != nil {
+				return
+			}
+			for dec.More() {
+				var termType string
+				if termType, err = readJSONKey(dec); err != nil {
+					return
+				}
+				// Reserved terms are huge and never used
+				if termType != "OnDemand" {
+					if err = skipJSONValue(dec); err != nil {
+						return
+					}
+					continue
+				}
+				if err = expectJSONDelim(dec, '{'); err != nil {
+					return
+				}
+				for dec.More() {
+					var sku string
+					if sku, err = readJSONKey(dec); err != nil {
+						return
+					}
+					var offers map[string]struct {
+						PriceDimensions map[string]struct {
+							BeginRange   string            `json:"beginRange"`
+							PricePerUnit map[string]string `json:"pricePerUnit"`
+						} `json:"priceDimensions"`
+					}
+					if err = dec.Decode(&offers); err != nil {
+						return
+					}
+					// Dimensions are taken in the sorted order preferring the first tier,
+					// so tiered prices do not change between restarts
+				offersLoop:
+					for _, offerKey := range slices.Sorted(maps.Keys(offers)) {
+						dimensions := offers[offerKey].PriceDimensions
+						for _, dimensionKey := range slices.Sorted(maps.Keys(dimensions)) {
+							dimension := dimensions[dimensionKey]
+							if _, exists := prices[sku]; len(dimension.PricePerUnit) == 0 ||
+								(exists && !isFirstPriceTier(dimension.BeginRange)) {
+								continue
+							}
+							currency := slices.Sorted(maps.Keys(dimension.PricePerUnit))[0]
+							prices[sku] = price{value: dimension.PricePerUnit[currency], currency: currency,
+								firstTier: isFirstPriceTier(dimension.BeginRange)}
+							if prices[sku].firstTier {
+								break offersLoop
+							}
+						}
+					}
+				}
+				if err = expectJSONDelim(dec, '}'); err != nil {
+					return
+				}
+			}
+			if err = expectJSONDelim(dec, '}'); err != nil {
+				return
+			}
+
+		default:
+			if err = skipJSONValue(dec); err != nil {
+				return
+			}
+		}
+	}
+
+	// Terms may come before or after the products, so join them in the end
+	for sku, product := range bySKU {
+		if p, exists := prices[sku]; exists {
+			product.price, product.currency = p.value, p.currency
+			products = append(products, product)
+		}
+	}
+	return
+}
+
+// parseOfferCSV reads the CSV offer file: a few metadata lines, the header and a row per price dimension
+func parseOfferCSV(reader io.Reader) (products []*priceListProduct, publishedAt time.Time, err error) {
+	r := csv.NewReader(reader)
+	r.FieldsPerRecord = -1
+
+	// Metadata goes before the header
+	var header []string
+	for header == nil {
+		var record []string
+		if record, err = r.Read(); err != nil {
+			if errors.Is(err, io.EOF) {
+				err = errors.New("no header found in the CSV offer file")
+			}
+			return
+		}
+		switch {
+		case len(record) >= 2 && record[0] == "Publication Date":
+			if publishedAt, err = time.Parse(time.RFC3339, record[1]); err != nil {
+				return
+			}
+		case len(record) > 0 && record[0] == "SKU":
+			header = record
+		}
+	}
+
+	columns := map[string]int{}
+	for i, name := range header {
+		columns[normalizeAttribute(name)] = i
+	}
+	for _, required := range []string{"SKU", "Term Type", "Product Family", "Price Per Unit", "Currency"} {
+		if _, exists := columns[normalizeAttribute(required)]; !exists {
+			err = fmt.Errorf("no %s column found in the CSV offer file", required)
+			return
+		}
+	}
+	get := func(record []string, name string) string {
+		if i, exists := columns[normalizeAttribute(name)]; exists && i < len(record) {
+			return record[i]
+		}
+		return ""
+	}
+
+	// Tiered prices come in a row per tier, the first tier is kept
+	bySKU := map[string]*priceListProduct{}
+	var order []string
+	for {
+		var record []string
+		if record, err = r.Read(); err != nil {
+			if errors.Is(err, io.EOF) {
+				err = nil
+				break
+			}
+			return
+		}
+		sku := get(record, "SKU")
+		if get(record, "Term Type") != "OnDemand" || !isPriceListProductFamily(get(record, "Product Family")) {
+			continue
+		}
+		if _, exists := bySKU[sku]; exists && !isFirstPriceTier(get(record, "StartingRange")) {
+			continue
+		}
+		product := &priceListProduct{
+			attributes: map[string]string{},
+			price:      get(record, "Price Per Unit"),
+			currency:   get(record, "Currency"),
+		}
+		for name, i := range columns {
+			if isPriceListAttribute(name) && i < len(record) {
+				product.attributes[name] = record[i]
+			}
+		}
+		if _, exists := bySKU[sku]; !exists {
+			order = append(order, sku)
+		}
+		bySKU[sku] = product
+	}
+	for _, sku := range order {
+		products = append(products, bySKU[sku])
+	}
+	return
+}
+
+// isFirstPriceTier reports whether the price dimension starts from zero or has no range at all
+func isFirstPriceTier(beginRange string) bool {
+	return beginRange == "" || beginRange == "0"
+}
+
+func expectJSONDelim(dec *json.Decoder, delim json.Delim) (err error) {
+	var token json.Token
+	if token, err = dec.Token(); err != nil {
+		return
+	}
+	if d, ok := token.(json.Delim); !ok || d != delim {
+		err = fmt.Errorf("expected %s in the JSON offer file, got %v", delim, token)
+	}
+	return
+}
+
+func readJSONKey(dec *json.Decoder) (key string, err error) {
+	var token json.Token
+	if token, err = dec.Token(); err != nil {
+		return
+	}
+	var ok bool
+	if key, ok = token.(string); !ok {
+		err = fmt.Errorf("expected a key in the JSON offer file, got %v", token)
+	}
+	return
+}
+
+// skipJSONValue skips the next value token by token without loading it into memory
+func skipJSONValue(dec *json.Decoder) (err error) {
+	depth := 0
+	for {
+		var token json.Token
+		if token, err = dec.Token(); err != nil {
+			return
+		}
+		if delim, ok := token.(json.Delim); ok {
+			switch delim {
+			case '{', '[':
+				depth++
+			case '}', ']':
+				depth--
+			}
+		}
+		if depth == 0 {
+			return
+		}
+	}
+}
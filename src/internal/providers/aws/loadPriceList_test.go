@@ -0,0 +1,287 @@
+// Copyright 2025 The MoneyPod Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package aws
+
+import (
+	"compress/gzip"
+	"os"
+	"path/filepath"
+	"time"
+
+	ec2Types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	pricingTypes "github.com/aws/aws-sdk-go-v2/service/pricing/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/vlasov-y/moneypod/internal/monitoring"
+	"k8s.io/utils/ptr"
+)
+
+const offerJSON = `{
+  "formatVersion": "v1.0",
+  "offerCode": "AmazonEC2",
+  "publicationDate": "2025-09-30T18:15:36Z",
+  "products": {
+    "SKU1": {
+      "sku": "SKU1",
+      "productFamily": "Compute Instance",
+      "attributes": {
+        "instanceType": "t3a.small", "regionCode": "eu-central-1", "operatingSystem": "Linux",
+        "capacitystatus": "Used", "preInstalledSw": "NA", "tenancy": "Shared", "vcpu": "2"
+      }
+    },
+    "SKU2": {
+      "sku": "SKU2",
+      "productFamily": "Compute Instance",
+      "attributes": {
+        "instanceType": "t3a.small", "regionCode": "eu-central-1", "operatingSystem": "Windows",
+        "capacitystatus": "Used", "preInstalledSw": "NA", "tenancy": "Shared"
+      }
+    },
+    "SKU5": {
+      "sku": "SKU5",
+      "productFamily": "Compute Instance",
+      "attributes": {
+        "instanceType": "t3a.small", "regionCode": "eu-central-1", "operatingSystem": "RHEL",
+        "capacitystatus": "Used", "preInstalledSw": "NA", "tenancy": "Shared",
+        "licenseModel": "No License required", "operation": "RunInstances:0010"
+      }
+    },
+    "SKU6": {
+      "sku": "SKU6",
+      "productFamily": "Compute Instance",
+      "attributes": {
+        "instanceType": "t3a.small", "regionCode": "eu-central-1", "operatingSystem": "Windows",
+        "capacitystatus": "Used", "preInstalledSw": "SQL Std", "tenancy": "Shared",
+        "licenseModel": "No License required", "operation": "RunInstances:0006"
+      }
+    },
+    "SKU7": {
+      "sku": "SKU7",
+      "productFamily": "Compute Instance",
+      "attributes": {
+        "instanceType": "t3a.small", "regionCode": "eu-central-1", "operatingSystem": "Linux",
+        "capacitystatus": "AllocatedCapacityReservation", "preInstalledSw": "NA", "tenancy": "Dedicated"
+      }
+    },
+    "SKU8": {
+      "sku": "SKU8",
+      "productFamily": "Dedicated Host",
+      "attributes": {"instanceType": "m5", "regionCode": "eu-central-1", "tenancy": "Host"}
+    },
+    "SKU3": {
+      "sku": "SKU3",
+      "productFamily": "Data Transfer",
+      "attributes": {"regionCode": "eu-central-1"}
+    }
+  },
+  "terms": {
+    "OnDemand": {
+      "SKU1": {"SKU1.JRTCKXETXF": {"priceDimensions": {"SKU1.JRTCKXETXF.6YS6EN2CT7": {
+        "unit": "Hrs", "pricePerUnit": {"USD": "0.0216000000"}}}}},
+      "SKU2": {"SKU2.JRTCKXETXF": {"priceDimensions": {"SKU2.JRTCKXETXF.6YS6EN2CT7": {
+        "unit": "Hrs", "pricePerUnit": {"USD": "0.0400000000"}}}}},
+      "SKU5": {"SKU5.JRTCKXETXF": {"priceDimensions": {"SKU5.JRTCKXETXF.6YS6EN2CT7": {
+        "unit": "Hrs", "pricePerUnit": {"USD": "0.0504000000"}}}}},
+      "SKU6": {"SKU6.JRTCKXETXF": {"priceDimensions": {"SKU6.JRTCKXETXF.6YS6EN2CT7": {
+        "unit": "Hrs", "pricePerUnit": {"USD": "0.1600000000"}}}}},
+      "SKU7": {"SKU7.JRTCKXETXF": {"priceDimensions": {"SKU7.JRTCKXETXF.6YS6EN2CT7": {
+        "unit": "Hrs", "pricePerUnit": {"USD": "0.0238000000"}}}}},
+      "SKU8": {"SKU8.JRTCKXETXF": {"priceDimensions": {"SKU8.JRTCKXETXF.6YS6EN2CT7": {
+        "unit": "Hrs", "pricePerUnit": {"USD": "5.0820000000"}}}}},
+      "SKU3": {"SKU3.JRTCKXETXF": {"priceDimensions": {"SKU3.JRTCKXETXF.6YS6EN2CT7": {
+        "unit": "GB", "pricePerUnit": {"USD": "0.09"}}}}}
+    },
+    "Reserved": {
+      "SKU1": {"SKU1.4NA7Y494T4": {"priceDimensions": {}, "termAttributes": {"LeaseContractLength": "1yr"}}}
+    }
+  }
+}`
+
+const offerCSV = `"FormatVersion","v1.0"
+"Disclaimer","This pricing list is for informational purposes only."
+"Publication Date","2025-10-01T10:00:00Z"
+"Version","20251001100000"
+"OfferCode","AmazonEC2"
+"SKU","OfferTermCode","RateCode","TermType","PriceDescription","Unit","PricePerUnit","Currency","Product Family","Instance Type","Region Code","Operating System","CapacityStatus","Pre Installed S/W","Tenancy"
+"SKU4","JRTCKXETXF","SKU4.JRTCKXETXF.6YS6EN2CT7","OnDemand","$0.0832 per On Demand Linux m5.large","Hrs","0.0960000000","USD","Compute Instance","m5.large","us-east-1","Linux","Used","NA","Shared"
+"SKU4","4NA7Y494T4","SKU4.4NA7Y494T4.6YS6EN2CT7","Reserved","Linux m5.large reserved","Hrs","0.0600000000","USD","Compute Instance","m5.large","us-east-1","Linux","Used","NA","Shared"
+`
+
+var _ = Describe("loadPriceList", Ordered, func() {
+	var dir string
+
+	instance := func(instanceType string, platform ec2Types.PlatformValues) ec2Types.Instance {
+		return ec2Types.Instance{
+			InstanceType: ec2Types.InstanceType(instanceType),
+			Platform:     platform,
+			Placement:    &ec2Types.Placement{AvailabilityZone: ptr.To("eu-central-1a")},
+		}
+	}
+
+	BeforeEach(func() {
+		dir = GinkgoT().TempDir()
+		Expect(os.WriteFile(filepath.Join(dir, "eu-central-1.json"), []byte(offerJSON), 0o600)).To(Succeed())
+		f, err := os.Create(filepath.Join(dir, "us-east-1.csv.gz"))
+		Expect(err).ToNot(HaveOccurred())
+		gz := gzip.NewWriter(f)
+		_, err = gz.Write([]byte(offerCSV))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(gz.Close()).To(Succeed())
+		Expect(f.Close()).To(Succeed())
+		Expect(os.WriteFile(filepath.Join(dir, "README.md"), []byte("not an offer file"), 0o600)).To(Succeed())
+	})
+
+	Context("when loading a directory with offer files", func() {
+		It("should index compute instances from all of them", func() {
+			pl, err := loadPriceList(ctx, dir)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(pl.products).To(HaveLen(3))
+			Expect(pl.products["t3a.small"]).To(HaveLen(5))
+			Expect(pl.products["m5"]).To(HaveLen(1))
+			Expect(pl.products["m5.large"]).To(HaveLen(1))
+			Expect(testutil.ToFloat64(monitoring.AWSPriceListPublishedAtMetric.WithLabelValues(
+				"eu-central-1", "eu-central-1.json"))).To(BeEquivalentTo(time.Date(2025, 9, 30, 18, 15, 36, 0, time.UTC).Unix()))
+			Expect(testutil.ToFloat64(monitoring.AWSPriceListPublishedAtMetric.WithLabelValues(
+				"us-east-1", "us-east-1.csv.gz"))).To(BeEquivalentTo(time.Date(2025, 10, 1, 10, 0, 0, 0, time.UTC).Unix()))
+		})
+
+		It("should find the price with the Pricing API filters", func() {
+			pl, err := loadPriceList(ctx, dir)
+			Expect(err).ToNot(HaveOccurred())
+
+			product, found := pl.lookup(provider.getPricingFilters(instance("t3a.small", ""), "eu-central-1", capacityStatusUsed))
+			Expect(found).To(BeTrue())
+			Expect(product.price).To(Equal("0.0216000000"))
+			Expect(product.currency).To(Equal("USD"))
+
+			product, found = pl.lookup(provider.getPricingFilters(instance("t3a.small", "windows"), "eu-central-1", capacityStatusUsed))
+			Expect(found).To(BeTrue())
+			Expect(product.price).To(Equal("0.0400000000"))
+
+			product, found = pl.lookup(provider.getPricingFilters(instance("m5.large", ""), "us-east-1", capacityStatusUsed))
+			Expect(found).To(BeTrue())
+			Expect(product.price).To(Equal("0.0960000000"))
+		})
+
+		It("should find the price of the licensed operating system", func() {
+			pl, err := loadPriceList(ctx, dir)
+			Expect(err).ToNot(HaveOccurred())
+
+			rhel := instance("t3a.small", "")
+			rhel.UsageOperation = ptr.To("RunInstances:0010")
+			product, found := pl.lookup(provider.getPricingFilters(rhel, "eu-central-1", capacityStatusUsed))
+			Expect(found).To(BeTrue())
+			Expect(product.price).To(Equal("0.0504000000"))
+
+			sql := instance("t3a.small", "windows")
+			sql.UsageOperation = ptr.To("RunInstances:0006")
+			product, found = pl.lookup(provider.getPricingFilters(sql, "eu-central-1", capacityStatusUsed))
+			Expect(found).To(BeTrue())
+			Expect(product.price).To(Equal("0.1600000000"))
+
+			suse := instance("t3a.small", "")
+			suse.UsageOperation = ptr.To("RunInstances:000g")
+			_, found = pl.lookup(provider.getPricingFilters(suse, "eu-central-1", capacityStatusUsed))
+			Expect(found).To(BeFalse())
+		})
+
+		It("should find the price of the dedicated capacity", func() {
+			pl, err := loadPriceList(ctx, dir)
+			Expect(err).ToNot(HaveOccurred())
+
+			dedicated := instance("t3a.small", "")
+			dedicated.Placement.Tenancy = ec2Types.TenancyDedicated
+			product, found := pl.lookup(provider.getPricingFilters(dedicated, "eu-central-1", capacityStatusAllocatedReservation))
+			Expect(found).To(BeTrue())
+			Expect(product.price).To(Equal("0.0238000000"))
+
+			_, found = pl.lookup(provider.getPricingFilters(dedicated, "eu-central-1", capacityStatusUsed))
+			Expect(found).To(BeFalse())
+
+			product, found = pl.lookup([]pricingTypes.Filter{
+				{Field: ptr.To("productFamily"), Value: ptr.To("Dedicated Host"), Type: pricingTypes.FilterTypeTermMatch},
+				{Field: ptr.To("instanceType"), Value: ptr.To("m5"), Type: pricingTypes.FilterTypeTermMatch},
+				{Field: ptr.To("regionCode"), Value: ptr.To("eu-central-1"), Type: pricingTypes.FilterTypeTermMatch},
+			})
+			Expect(found).To(BeTrue())
+			Expect(product.price).To(Equal("5.0820000000"))
+		})
+
+		It("should not find absent products", func() {
+			pl, err := loadPriceList(ctx, dir)
+			Expect(err).ToNot(HaveOccurred())
+			_, found := pl.lookup(provider.getPricingFilters(instance("m5.large", ""), "eu-central-1", capacityStatusUsed))
+			Expect(found).To(BeFalse())
+			_, found = pl.lookup(provider.getPricingFilters(instance("c5.large", ""), "us-east-1", capacityStatusUsed))
+			Expect(found).To(BeFalse())
+		})
+	})
+
+	Context("when loading a single offer file", func() {
+		It("should load only that file", func() {
+			pl, err := loadPriceList(ctx, filepath.Join(dir, "eu-central-1.json"))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(pl.products).To(HaveKey("t3a.small"))
+			Expect(pl.products).ToNot(HaveKey("m5.large"))
+		})
+	})
+
+	Context("when the price is tiered", func() {
+		It("should take the first tier", func() {
+			for name, content := range map[string]string{
+				"tiered.json": `{"products": {"IOPS": {"productFamily": "System Operation",
+  "attributes": {"regionCode": "eu-central-1", "volumeApiName": "io2"}}},
+"terms": {"OnDemand": {"IOPS": {"IOPS.T": {"priceDimensions": {
+  "IOPS.T.A": {"beginRange": "64000", "endRange": "Inf", "pricePerUnit": {"USD": "0.0390000000"}},
+  "IOPS.T.B": {"beginRange": "32000", "endRange": "64000", "pricePerUnit": {"USD": "0.0546000000"}},
+  "IOPS.T.C": {"beginRange": "0", "endRange": "32000", "pricePerUnit": {"USD": "0.0780000000"}}}}}}}}`,
+				"tiered.csv": `"SKU","TermType","PricePerUnit","Currency","StartingRange","Product Family","Region Code","Volume API Name"
+"IOPS","OnDemand","0.0546000000","USD","32000","System Operation","eu-central-1","io2"
+"IOPS","OnDemand","0.0780000000","USD","0","System Operation","eu-central-1","io2"
+"IOPS","OnDemand","0.0390000000","USD","64000","System Operation","eu-central-1","io2"`,
+			} {
+				By(name)
+				path := filepath.Join(GinkgoT().TempDir(), name)
+				Expect(os.WriteFile(path, []byte(content), 0o600)).To(Succeed())
+				pl, err := loadPriceList(ctx, path)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(pl.products[""]).To(HaveLen(1))
+				Expect(pl.products[""][0].price).To(Equal("0.0780000000"))
+			}
+		})
+	})
+
+	Context("when the price list is broken", func() {
+		It("should return an error", func() {
+			for name, content := range map[string]string{
+				"broken.json": `{"products": [}`,
+				"empty.json":  `{"products": {}, "terms": {}}`,
+				"broken.csv":  `"FormatVersion","v1.0"`,
+			} {
+				By(name)
+				path := filepath.Join(GinkgoT().TempDir(), name)
+				Expect(os.WriteFile(path, []byte(content), 0o600)).To(Succeed())
+				_, err = loadPriceList(ctx, path)
+				Expect(err).To(HaveOccurred())
+			}
+		})
+
+		It("should return an error for an absent path", func() {
+			_, err = loadPriceList(ctx, filepath.Join(dir, "absent"))
+			Expect(err).To(HaveOccurred())
+		})
+	})
+})
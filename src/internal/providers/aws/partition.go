@@ -0,0 +1,74 @@
+// Copyright 2025 The MoneyPod Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package aws
+
+import (
+	"regexp"
+	"strings"
+)
+
+// partition describes pricing specifics of the AWS partition.
+type partition struct {
+	// Partition name: aws, aws-cn, etc.
+	name string
+	// Region of the Pricing API endpoint, empty if partition has no Pricing API
+	pricingRegion string
+	// Currency of the prices in the partition
+	currency string
+}
+
+var (
+	partitionAWS      = partition{name: "aws", pricingRegion: "us-east-1", currency: "USD"}
+	partitionAWSChina = partition{name: "aws-cn", pricingRegion: "cn-northwest-1", currency: "CNY"}
+	partitionAWSGov   = partition{name: "aws-us-gov", currency: "USD"}
+	partitionAWSISO   = partition{name: "aws-iso", currency: "USD"}
+
+	partitions = []partition{partitionAWS, partitionAWSChina, partitionAWSGov, partitionAWSISO}
+)
+
+// Region is the AZ prefix up to the number: eu-central-1a, us-east-1-bos-1a, us-gov-west-1a, cn-north-1a
+var regionRegexp = regexp.MustCompile(`^[a-z]{2}(-gov|-iso[a-z]?)?-[a-z]+-\d+`)
+
+// getPartition returns the partition the region belongs to
+func getPartition(region string) partition {
+	switch {
+	case strings.HasPrefix(region, "cn-"):
+		return partitionAWSChina
+	case strings.HasPrefix(region, "us-gov-"):
+		return partitionAWSGov
+	case strings.Contains(region, "-iso"):
+		return partitionAWSISO
+	default:
+		return partitionAWS
+	}
+}
+
+// getRegion returns the region of the availability zone, Local Zones and Wavelength Zones included
+func getRegion(availabilityZone string) string {
+	if region := regionRegexp.FindString(availabilityZone); region != "" {
+		return region
+	}
+	return availabilityZone
+}
+
+// getZoneGroup guesses the pricing region code from the zone name:
+// us-west-2-lax-1a is priced as us-west-2-lax-1, us-east-1-wl1-bos-wlz-1 as is, eu-central-1a as eu-central-1
+func getZoneGroup(availabilityZone string) string {
+	region := getRegion(availabilityZone)
+	if len(availabilityZone) <= len(region)+1 {
+		return region
+	}
+	return strings.TrimRight(availabilityZone, "abcdefghijklmnopqrstuvwxyz")
+}
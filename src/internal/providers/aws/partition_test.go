@@ -0,0 +1,66 @@
+// Copyright 2025 The MoneyPod Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package aws
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("partition", func() {
+	Context("when getting the region of the availability zone", func() {
+		It("should cut the zone suffix", func() {
+			for zone, region := range map[string]string{
+				"eu-central-1a":       "eu-central-1",
+				"us-east-1-bos-1a":    "us-east-1",
+				"us-west-2-lax-1b":    "us-west-2",
+				"us-east-1-wl1-bos-1": "us-east-1",
+				"us-gov-west-1a":      "us-gov-west-1",
+				"cn-northwest-1b":     "cn-northwest-1",
+				"ap-southeast-10a":    "ap-southeast-10",
+			} {
+				By(zone)
+				Expect(getRegion(zone)).To(Equal(region))
+			}
+		})
+	})
+
+	Context("when getting the zone group of the availability zone", func() {
+		It("should keep the Local Zone and Wavelength Zone group", func() {
+			for zone, group := range map[string]string{
+				"eu-central-1a":           "eu-central-1",
+				"ap-southeast-10a":        "ap-southeast-10",
+				"us-west-2-lax-1a":        "us-west-2-lax-1",
+				"us-east-1-bos-1a":        "us-east-1-bos-1",
+				"us-east-1-wl1-bos-wlz-1": "us-east-1-wl1-bos-wlz-1",
+				"us-gov-west-1a":          "us-gov-west-1",
+			} {
+				By(zone)
+				Expect(getZoneGroup(zone)).To(Equal(group))
+			}
+		})
+	})
+
+	Context("when getting the partition of the region", func() {
+		It("should return the pricing endpoint and currency", func() {
+			Expect(getPartition("eu-central-1")).To(Equal(partitionAWS))
+			Expect(getPartition("cn-north-1")).To(Equal(partitionAWSChina))
+			Expect(getPartition("cn-north-1").currency).To(Equal("CNY"))
+			Expect(getPartition("us-gov-east-1")).To(Equal(partitionAWSGov))
+			Expect(getPartition("us-gov-east-1").pricingRegion).To(BeEmpty())
+			Expect(getPartition("us-isob-east-1")).To(Equal(partitionAWSISO))
+		})
+	})
+})
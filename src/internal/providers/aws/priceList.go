@@ -0,0 +1,114 @@
+// Copyright 2025 The MoneyPod Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package aws
+
+import (
+	"strings"
+	"unicode"
+
+	pricingTypes "github.com/aws/aws-sdk-go-v2/service/pricing/types"
+)
+
+// Product families kept in memory from the offer files, everything else is skipped while parsing
+var priceListProductFamilies = []string{
+	"Compute Instance", "Dedicated Host", productFamilyStorage, productFamilyIOPS, productFamilyThroughput,
+}
+
+// Product attributes kept in memory from the offer files, only they can be used in the filters
+var priceListAttributes = []string{
+	"productFamily", "instanceType", "regionCode", "operatingSystem", "tenancy",
+	"capacitystatus", "preInstalledSw", "licenseModel", "operation", "volumeApiName",
+}
+
+// priceListProduct is a single SKU from the offer file with its on-demand price.
+type priceListProduct struct {
+	attributes map[string]string
+	// Price per unit as written in the offer file
+	price string
+	// Currency of the price: USD or CNY
+	currency string
+}
+
+// priceList is an in-memory index of the bulk price list offer files.
+type priceList struct {
+	// Products indexed by the instance type, products without the instance type use an empty key
+	products map[string][]*priceListProduct
+}
+
+func newPriceList() *priceList {
+	return &priceList{
+		products: map[string][]*priceListProduct{},
+	}
+}
+
+// normalizeAttribute converts JSON attributes and CSV column names to the same form.
+// E.g. "Pre Installed S/W" and "preInstalledSw" are both "preinstalledsw".
+func normalizeAttribute(name string) string {
+	return strings.Map(func(r rune) rune {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			return unicode.ToLower(r)
+		}
+		return -1
+	}, name)
+}
+
+// isPriceListAttribute reports whether the normalized attribute must be kept in memory
+func isPriceListAttribute(name string) bool {
+	for _, attribute := range priceListAttributes {
+		if normalizeAttribute(attribute) == name {
+			return true
+		}
+	}
+	return false
+}
+
+// isPriceListProductFamily reports whether the product family must be kept in memory
+func isPriceListProductFamily(family string) bool {
+	for _, f := range priceListProductFamilies {
+		if f == family {
+			return true
+		}
+	}
+	return false
+}
+
+func (pl *priceList) add(product *priceListProduct) {
+	key := product.attributes[normalizeAttribute("instanceType")]
+	pl.products[key] = append(pl.products[key], product)
+}
+
+// lookup finds the first product matching all the filters just like the Pricing API GetProducts does
+func (pl *priceList) lookup(filters []pricingTypes.Filter) (product *priceListProduct, found bool) {
+	var instanceType string
+	for _, filter := range filters {
+		if normalizeAttribute(*filter.Field) == normalizeAttribute("instanceType") {
+			instanceType = *filter.Value
+		}
+	}
+
+	for _, candidate := range pl.products[instanceType] {
+		matches := true
+		for _, filter := range filters {
+			if candidate.attributes[normalizeAttribute(*filter.Field)] != *filter.Value {
+				matches = false
+				break
+			}
+		}
+		if matches {
+			return candidate, true
+		}
+	}
+	return
+}
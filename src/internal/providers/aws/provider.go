@@ -14,4 +14,155 @@
 
 package aws
 
-type Provider struct{}
+import (
+	"context"
+	"errors"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	ec2 "github.com/aws/aws-sdk-go-v2/service/ec2"
+	pricing "github.com/aws/aws-sdk-go-v2/service/pricing"
+	"github.com/vlasov-y/moneypod/internal/types"
+
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+// ErrNotConfigured is returned by the provider that has no clients, i.e. was not created with NewProvider
+var ErrNotConfigured = errors.New("AWS provider is not configured")
+
+// Options configures the AWS provider.
+type Options struct {
+	// Path to the bulk price list offer file or to a directory with offer files
+	PriceListPath string
+	// OCI artifact reference with the bulk price list offer files
+	PriceListReference string
+	// Pull the OCI artifact over plain HTTP
+	PriceListPlainHTTP bool
+	// Path to the file with rates for the regions not covered by the Pricing API
+	RatesPath string
+	// Price nodes from their labels without calling AWS APIs
+	NoCredentials bool
+	// Role to assume for all AWS API calls, e.g. in another account
+	RoleARN string
+	// External ID required by the role trust policy
+	ExternalID string
+	// Endpoint overrides, e.g. VPC endpoints or LocalStack
+	EC2Endpoint     string
+	PricingEndpoint string
+	STSEndpoint     string
+	// Maximum number of attempts per AWS API call, SDK default if zero
+	MaxAttempts int
+	// Maximum delay between the retries, SDK default if zero
+	MaxBackoff time.Duration
+}
+
+type Provider struct {
+	// Prices loaded from the bulk price list, nil if Pricing API is used
+	priceList *priceList
+	// Configured rates used when no price is found otherwise
+	rates []rate
+	// Node labels are used instead of AWS APIs
+	noCredentials bool
+	// Clients shared across reconciles, nil without credentials
+	clientEc2 *ec2.Client
+	// Pricing API clients per partition pricing region
+	clientsPricing map[string]*pricing.Client
+	// Pricing region codes of the described availability zones
+	zones      map[string]string
+	zonesMutex sync.RWMutex
+}
+
+// NewProvider creates the AWS provider and loads everything it needs to be shared across reconciles.
+func NewProvider(ctx context.Context, opts Options) (provider *Provider, err error) {
+	log := logf.FromContext(ctx)
+	provider = &Provider{noCredentials: opts.NoCredentials}
+
+	// Pull the offer files from the registry to a temporary directory
+	path := opts.PriceListPath
+	if opts.PriceListReference != "" {
+		if path, err = os.MkdirTemp("", "moneypod-price-list-"); err != nil {
+			log.Error(err, "failed to create a directory for the price list")
+			return
+		}
+		defer os.RemoveAll(path)
+		if err = pullPriceList(ctx, opts.PriceListReference, opts.PriceListPlainHTTP, path); err != nil {
+			return
+		}
+	}
+
+	if path != "" {
+		if provider.priceList, err = loadPriceList(ctx, path); err != nil {
+			return
+		}
+	}
+
+	if opts.RatesPath != "" {
+		if provider.rates, err = loadRates(ctx, opts.RatesPath); err != nil {
+			return
+		}
+	}
+
+	// Without credentials prices can be taken only from the files
+	if provider.noCredentials {
+		if provider.priceList == nil && provider.rates == nil {
+			err = errors.New("price list or rates are required without AWS credentials")
+			log.Error(err, "invalid AWS provider options")
+		}
+		return
+	}
+
+	// Build the clients once
+	var awsConfig aws.Config
+	if awsConfig, err = LoadConfig(ctx, opts); err != nil {
+		return
+	}
+	provider.clientEc2 = ec2.NewFromConfig(awsConfig, func(o *ec2.Options) {
+		if opts.EC2Endpoint != "" {
+			o.BaseEndpoint = aws.String(opts.EC2Endpoint)
+		}
+	})
+	provider.clientsPricing = map[string]*pricing.Client{}
+	for _, p := range partitions {
+		if p.pricingRegion == "" {
+			continue
+		}
+		provider.clientsPricing[p.pricingRegion] = pricing.NewFromConfig(awsConfig, func(o *pricing.Options) {
+			o.Region = p.pricingRegion // Pricing API is available only in a few regions per partition
+			if opts.PricingEndpoint != "" {
+				o.BaseEndpoint = aws.String(opts.PricingEndpoint)
+			}
+		})
+	}
+
+	return
+}
+
+// Start exports the cost of the unused capacity reservations every hour until the context is cancelled.
+// Without credentials there is nothing to describe.
+func (provider *Provider) Start(ctx context.Context) error {
+	if provider.clientEc2 == nil {
+		return nil
+	}
+	log := logf.FromContext(ctx).WithName("aws")
+	ctx = logf.IntoContext(ctx, log)
+
+	ticker := time.NewTicker(types.CostRefreshInterval)
+	defer ticker.Stop()
+	for {
+		if err := provider.updateCapacityReservationsCost(ctx); err != nil {
+			log.Error(err, "failed to update the capacity reservations cost")
+		}
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// NeedLeaderElection lets only the leader export the account-wide costs.
+func (provider *Provider) NeedLeaderElection() bool {
+	return true
+}
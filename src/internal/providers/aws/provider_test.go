@@ -0,0 +1,81 @@
+// Copyright 2025 The MoneyPod Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package aws
+
+import (
+	"os"
+	"path/filepath"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+)
+
+var _ = Describe("NewProvider", func() {
+	It("should build the clients once with the configured endpoints", func() {
+		GinkgoT().Setenv("AWS_REGION", "eu-central-1")
+		p, err := NewProvider(ctx, Options{
+			EC2Endpoint:     "http://localhost:4566",
+			PricingEndpoint: "http://localhost:4567",
+			MaxAttempts:     5,
+			MaxBackoff:      time.Minute,
+		})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(p.clientEc2).ToNot(BeNil())
+		Expect(*p.clientEc2.Options().BaseEndpoint).To(Equal("http://localhost:4566"))
+		Expect(p.clientEc2.Options().Retryer.MaxAttempts()).To(Equal(5))
+
+		Expect(p.clientsPricing).To(HaveLen(2))
+		for region, client := range p.clientsPricing {
+			Expect(client.Options().Region).To(Equal(region))
+			Expect(*client.Options().BaseEndpoint).To(Equal("http://localhost:4567"))
+		}
+	})
+
+	It("should assume the role", func() {
+		GinkgoT().Setenv("AWS_REGION", "eu-central-1")
+		p, err := NewProvider(ctx, Options{
+			RoleARN:    "arn:aws:iam::123456789012:role/moneypod",
+			ExternalID: "moneypod",
+		})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(p.clientEc2.Options().Credentials).ToNot(BeNil())
+	})
+
+	It("should not build the clients without credentials", func() {
+		path := filepath.Join(GinkgoT().TempDir(), "rates.yaml")
+		Expect(os.WriteFile(path, []byte("- hourlyCost: 0.1"), 0o600)).To(Succeed())
+		p, err := NewProvider(ctx, Options{NoCredentials: true, RatesPath: path})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(p.clientEc2).To(BeNil())
+		Expect(p.clientsPricing).To(BeEmpty())
+	})
+
+	It("should fail without the clients instead of panicking", func() {
+		p := &Provider{}
+		node := &corev1.Node{Spec: corev1.NodeSpec{ProviderID: "aws:///eu-central-1a/i-02634bb78e730ced1"}}
+		_, err := p.GetNodeHourlyCost(ctx, recorder, node)
+		Expect(err).To(MatchError(ErrNotConfigured))
+		_, err = p.GetNodeInfo(ctx, recorder, node)
+		Expect(err).To(MatchError(ErrNotConfigured))
+		_, err = p.GetPersistentVolumeHourlyCost(ctx, recorder, &corev1.PersistentVolume{
+			Spec: corev1.PersistentVolumeSpec{PersistentVolumeSource: corev1.PersistentVolumeSource{
+				CSI: &corev1.CSIPersistentVolumeSource{Driver: CSIDriver, VolumeHandle: "vol-0123456789"},
+			}},
+		})
+		Expect(err).To(MatchError(ErrNotConfigured))
+	})
+})
@@ -0,0 +1,52 @@
+// Copyright 2025 The MoneyPod Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package aws
+
+import (
+	"context"
+
+	"oras.land/oras-go/v2"
+	"oras.land/oras-go/v2/content/file"
+	"oras.land/oras-go/v2/registry/remote"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+// pullPriceList downloads offer files from the OCI artifact to the directory.
+// Artifact layers must have file names set, which is the default for `oras push`.
+func pullPriceList(ctx context.Context, reference string, plainHTTP bool, dir string) (err error) {
+	log := logf.FromContext(ctx).WithValues("reference", reference)
+
+	var repo *remote.Repository
+	if repo, err = remote.NewRepository(reference); err != nil {
+		log.Error(err, "failed to parse the price list reference")
+		return
+	}
+	repo.PlainHTTP = plainHTTP
+
+	var store *file.Store
+	if store, err = file.New(dir); err != nil {
+		log.Error(err, "failed to create the price list file store")
+		return
+	}
+	defer store.Close()
+
+	log.Info("pulling the price list")
+	tag := repo.Reference.Reference
+	if _, err = oras.Copy(ctx, repo, tag, store, tag, oras.DefaultCopyOptions); err != nil {
+		log.Error(err, "failed to pull the price list")
+		return
+	}
+	return
+}
@@ -0,0 +1,87 @@
+// Copyright 2025 The MoneyPod Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package aws
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/yaml"
+)
+
+// rate is a configured hourly cost used where prices cannot be queried.
+// Empty fields match anything, the most specific matching rate wins.
+type rate struct {
+	Region       string  `json:"region,omitempty"`
+	InstanceType string  `json:"instanceType,omitempty"`
+	Capacity     string  `json:"capacity,omitempty"`
+	Outpost      string  `json:"outpost,omitempty"`
+	HourlyCost   float64 `json:"hourlyCost"`
+}
+
+// loadRates reads the list of rates from the YAML or JSON file
+func loadRates(ctx context.Context, path string) (rates []rate, err error) {
+	log := logf.FromContext(ctx)
+
+	var data []byte
+	if data, err = os.ReadFile(path); err != nil {
+		log.Error(err, "failed to read the rates file", "path", path)
+		return
+	}
+	if err = yaml.UnmarshalStrict(data, &rates); err != nil {
+		log.Error(err, "failed to parse the rates file", "path", path)
+		return
+	}
+	for i, r := range rates {
+		if r.HourlyCost <= 0 {
+			err = fmt.Errorf("rate #%d has no positive hourlyCost", i)
+			log.Error(err, "invalid rates file", "path", path)
+			return
+		}
+	}
+	log.Info("loaded rates", "path", path, "count", len(rates))
+	return
+}
+
+// lookupRate finds the most specific rate matching the instance, outpost is empty for the instances not on Outposts
+func (provider *Provider) lookupRate(region string, instanceType string, capacity string,
+	outpost string) (hourlyCost float64, found bool) {
+	specificity := -1
+	for _, r := range provider.rates {
+		score := 0
+		for _, field := range []struct{ expected, actual string }{
+			{r.Region, region},
+			{r.InstanceType, instanceType},
+			{r.Capacity, capacity},
+			{r.Outpost, outpost},
+		} {
+			if field.expected == "" {
+				continue
+			}
+			if field.expected != field.actual {
+				score = -1
+				break
+			}
+			score++
+		}
+		if score > specificity {
+			specificity = score
+			hourlyCost, found = r.HourlyCost, true
+		}
+	}
+	return
+}
@@ -0,0 +1,124 @@
+// Copyright 2025 The MoneyPod Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package aws
+
+import (
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("rates", func() {
+	writeRates := func(content string) string {
+		path := filepath.Join(GinkgoT().TempDir(), "rates.yaml")
+		Expect(os.WriteFile(path, []byte(content), 0o600)).To(Succeed())
+		return path
+	}
+
+	Context("when rates file is valid", func() {
+		It("should pick the most specific rate", func() {
+			p := Provider{}
+			p.rates, err = loadRates(ctx, writeRates(`
+- hourlyCost: 1.0
+- region: us-gov-west-1
+  hourlyCost: 2.0
+- region: us-gov-west-1
+  instanceType: m5.large
+  hourlyCost: 3.0
+- instanceType: m5.large
+  hourlyCost: 4.0
+`))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(p.rates).To(HaveLen(4))
+
+			for _, tc := range []struct {
+				region, instanceType string
+				hourlyCost           float64
+			}{
+				{"us-gov-west-1", "m5.large", 3.0},
+				{"us-gov-west-1", "c5.large", 2.0},
+				{"us-gov-east-1", "m5.large", 4.0},
+				{"us-gov-east-1", "c5.large", 1.0},
+			} {
+				By(tc.region + "/" + tc.instanceType)
+				hourlyCost, found := p.lookupRate(tc.region, tc.instanceType, "on-demand", "")
+				Expect(found).To(BeTrue())
+				Expect(hourlyCost).To(Equal(tc.hourlyCost))
+			}
+		})
+
+		It("should match the capacity type", func() {
+			p := Provider{}
+			p.rates, err = loadRates(ctx, writeRates(`
+- instanceType: m5.large
+  hourlyCost: 0.096
+- instanceType: m5.large
+  capacity: spot
+  hourlyCost: 0.035
+`))
+			Expect(err).ToNot(HaveOccurred())
+			hourlyCost, found := p.lookupRate("eu-central-1", "m5.large", "spot", "")
+			Expect(found).To(BeTrue())
+			Expect(hourlyCost).To(Equal(0.035))
+			hourlyCost, found = p.lookupRate("eu-central-1", "m5.large", "on-demand", "")
+			Expect(found).To(BeTrue())
+			Expect(hourlyCost).To(Equal(0.096))
+		})
+
+		It("should match the outpost", func() {
+			p := Provider{}
+			p.rates, err = loadRates(ctx, writeRates(`
+- region: us-west-2
+  hourlyCost: 0.1
+- region: us-west-2
+  outpost: op-0123456789abcdef0
+  hourlyCost: 0.05
+`))
+			Expect(err).ToNot(HaveOccurred())
+			hourlyCost, found := p.lookupRate("us-west-2", "m5.large", "on-demand", "op-0123456789abcdef0")
+			Expect(found).To(BeTrue())
+			Expect(hourlyCost).To(Equal(0.05))
+			hourlyCost, found = p.lookupRate("us-west-2", "m5.large", "on-demand", "")
+			Expect(found).To(BeTrue())
+			Expect(hourlyCost).To(Equal(0.1))
+		})
+
+		It("should not match anything without a wildcard rate", func() {
+			p := Provider{}
+			p.rates, err = loadRates(ctx, writeRates(`[{"region": "us-gov-west-1", "hourlyCost": 2.0}]`))
+			Expect(err).ToNot(HaveOccurred())
+			_, found := p.lookupRate("us-gov-east-1", "m5.large", "on-demand", "")
+			Expect(found).To(BeFalse())
+		})
+	})
+
+	Context("when rates file is broken", func() {
+		It("should return an error", func() {
+			for _, content := range []string{
+				`- hourlyCost: 0`,
+				`- region: us-gov-west-1`,
+				`- unknownField: value
+  hourlyCost: 1.0`,
+				`not a list`,
+			} {
+				By(content)
+				_, err = loadRates(ctx, writeRates(content))
+				Expect(err).To(HaveOccurred())
+			}
+		})
+	})
+})
@@ -0,0 +1,96 @@
+// Copyright 2025 The MoneyPod Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package aws
+
+import (
+	"context"
+	"strconv"
+
+	ec2 "github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2Types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/vlasov-y/moneypod/internal/monitoring"
+	"k8s.io/utils/ptr"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+// updateCapacityReservationsCost exports the hourly cost of the reserved capacity no instance runs in
+// for every active capacity reservation of the account, so the fully unused ones are reported as well
+// and the expired or cancelled ones are dropped.
+func (provider *Provider) updateCapacityReservationsCost(ctx context.Context) (err error) {
+	log := logf.FromContext(ctx)
+
+	var reservations []ec2Types.CapacityReservation
+	paginator := ec2.NewDescribeCapacityReservationsPaginator(provider.clientEc2, &ec2.DescribeCapacityReservationsInput{
+		Filters: []ec2Types.Filter{{Name: ptr.To("state"), Values: []string{string(ec2Types.CapacityReservationStateActive)}}},
+	})
+	for paginator.HasMorePages() {
+		var page *ec2.DescribeCapacityReservationsOutput
+		if page, err = paginator.NextPage(ctx); err != nil {
+			log.Error(err, "failed to describe the capacity reservations")
+			return
+		}
+		reservations = append(reservations, page.CapacityReservations...)
+	}
+
+	costs := map[[3]string]float64{}
+	for _, reservation := range reservations {
+		reservationID := ptr.Deref(reservation.CapacityReservationId, "")
+		instanceType := ptr.Deref(reservation.InstanceType, "")
+		availabilityZone := ptr.Deref(reservation.AvailabilityZone, "")
+		region := provider.getLocation(ctx, availabilityZone)
+		log := log.WithValues("reservation", reservationID)
+
+		// Reservation is priced like an instance with the same type, tenancy and platform
+		instance := ec2Types.Instance{
+			InstanceType: ec2Types.InstanceType(instanceType),
+			Placement: &ec2Types.Placement{
+				AvailabilityZone: ptr.To(availabilityZone),
+				Tenancy:          ec2Types.Tenancy(reservation.Tenancy),
+			},
+		}
+		if operation, exists := reservationUsageOperations[reservation.InstancePlatform]; exists {
+			instance.UsageOperation = ptr.To(operation)
+		}
+
+		var priceStr string
+		var found bool
+		if priceStr, found, err = provider.getOnDemandPrice(ctx, region, instanceType,
+			provider.getPricingFilters(instance, region, capacityStatusUnusedReservation)); err != nil {
+			log.Error(err, "failed to get the unused reservation price", "instanceType", instanceType)
+			err = nil
+			continue
+		}
+		if !found {
+			log.Info("no pricing data found for the unused reservation", "instanceType", instanceType)
+			continue
+		}
+		var hourlyCost float64
+		if hourlyCost, err = strconv.ParseFloat(priceStr, 64); err != nil {
+			log.Error(err, "failed to parse the unused reservation price", "price", priceStr)
+			err = nil
+			continue
+		}
+
+		available := ptr.Deref(reservation.AvailableInstanceCount, 0)
+		log.V(1).Info("unused capacity reservation cost", "available", available, "price", hourlyCost)
+		costs[[3]string{reservationID, instanceType, availabilityZone}] = hourlyCost * float64(available)
+	}
+
+	monitoring.CapacityReservationUnusedHourlyCostMetric.Reset()
+	for labels, hourlyCost := range costs {
+		monitoring.CapacityReservationUnusedHourlyCostMetric.WithLabelValues(labels[:]...).Set(hourlyCost)
+	}
+	return
+}
@@ -31,6 +31,13 @@ func (provider *Provider) GetNodeInfo(ctx context.Context, r record.EventRecorde
 	}
 	info.ID = "manual"
 
+	// Currency and license are optional
+	info.Currency = DefaultCurrency
+	if currency := annotations[AnnotationNodeCurrency]; currency != "" {
+		info.Currency = currency
+	}
+	info.License = annotations[AnnotationNodeLicense]
+
 	// Get node's capacity from labels/annotations
 	if info.Capacity, exists = annotations[AnnotationNodeCapacity]; !exists {
 		r.Eventf(node, corev1.EventTypeWarning, "NoCapacity", fmt.Sprintf("%s is not defined", AnnotationNodeCapacity))
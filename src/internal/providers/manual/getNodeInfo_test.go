@@ -57,6 +57,15 @@ var _ = Describe("GetNodeInfo", Ordered, func() {
 			Expect(info.AvailabilityZone).To(Equal("eu-central-1b"))
 			Expect(info.Capacity).To(Equal("spot"))
 			Expect(info.Type).To(Equal("t3a.2xlarge"))
+			Expect(info.Currency).To(Equal(DefaultCurrency))
+		})
+
+		It("should get the custom currency", func() {
+			node.Annotations[AnnotationNodeCurrency] = "EUR"
+			var info NodeInfo
+			info, err = provider.GetNodeInfo(ctx, recorder, node)
+			ExpectWithOffset(1, err).ToNot(HaveOccurred())
+			Expect(info.Currency).To(Equal("EUR"))
 		})
 	})
 
@@ -24,6 +24,7 @@ import (
 	"github.com/vlasov-y/moneypod/internal/types"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
 )
 
 type Provider interface {
@@ -31,9 +32,43 @@ type Provider interface {
 	GetNodeInfo(ctx context.Context, r record.EventRecorder, node *corev1.Node) (info types.NodeInfo, err error)
 }
 
+// VolumeProvider calculates the cost of persistent volumes.
+type VolumeProvider interface {
+	GetPersistentVolumeHourlyCost(ctx context.Context, r record.EventRecorder, pv *corev1.PersistentVolume) (hourlyCost float64, err error)
+}
+
+// Options configures the providers on startup.
+type Options struct {
+	AWS aws.Options
+}
+
+// Providers are shared across reconciles, the AWS one returns aws.ErrNotConfigured until Setup is called
+var awsProvider = &aws.Provider{}
+
+// Setup creates the providers with the given options, it has to be called once before starting the manager.
+func Setup(ctx context.Context, opts Options) (err error) {
+	if awsProvider, err = aws.NewProvider(ctx, opts.AWS); err != nil {
+		return
+	}
+	return
+}
+
+// Jobs returns the providers to be started by the manager for the costs not bound to any node
+func Jobs() []manager.Runnable {
+	return []manager.Runnable{awsProvider}
+}
+
 func NewProvider(node *corev1.Node) (provider Provider) {
 	if strings.HasPrefix(node.Spec.ProviderID, "aws://") {
-		return &aws.Provider{}
+		return awsProvider
 	}
 	return &manual.Provider{}
 }
+
+// NewVolumeProvider returns the provider for the persistent volume or nil if its cost is not supported
+func NewVolumeProvider(pv *corev1.PersistentVolume) (provider VolumeProvider) {
+	if pv.Spec.CSI != nil && pv.Spec.CSI.Driver == aws.CSIDriver {
+		return awsProvider
+	}
+	return nil
+}
@@ -52,4 +52,24 @@ var _ = Describe("NodeReconciler", Ordered, func() {
 			Expect(reflect.TypeOf(provider)).To(Equal(reflect.TypeFor[*manual.Provider]()))
 		})
 	})
+
+	Context("when creating a volume provider", func() {
+		It("should return AWS provider for EBS CSI volumes", func() {
+			provider := NewVolumeProvider(&corev1.PersistentVolume{
+				Spec: corev1.PersistentVolumeSpec{PersistentVolumeSource: corev1.PersistentVolumeSource{
+					CSI: &corev1.CSIPersistentVolumeSource{Driver: aws.CSIDriver, VolumeHandle: "vol-0123456789"},
+				}},
+			})
+			Expect(reflect.TypeOf(provider)).To(Equal(reflect.TypeFor[*aws.Provider]()))
+		})
+
+		It("should return nothing for other volumes", func() {
+			Expect(NewVolumeProvider(&corev1.PersistentVolume{})).To(BeNil())
+			Expect(NewVolumeProvider(&corev1.PersistentVolume{
+				Spec: corev1.PersistentVolumeSpec{PersistentVolumeSource: corev1.PersistentVolumeSource{
+					CSI: &corev1.CSIPersistentVolumeSource{Driver: "efs.csi.aws.com"},
+				}},
+			})).To(BeNil())
+		})
+	})
 })
@@ -0,0 +1,55 @@
+// Copyright 2025 The MoneyPod Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// ResourceShares maps extended resources like nvidia.com/gpu to the share of the node cost all their units take.
+// It is parsed from the comma-separated list: nvidia.com/gpu=0.8,aws.amazon.com/neuron=0.7
+type ResourceShares map[string]float64
+
+// String formats the shares the way they are parsed
+func (shares ResourceShares) String() string {
+	var items []string
+	for name, share := range shares {
+		items = append(items, fmt.Sprintf("%s=%s", name, strconv.FormatFloat(share, 'f', -1, 64)))
+	}
+	sort.Strings(items)
+	return strings.Join(items, ",")
+}
+
+// Set adds the shares from the comma-separated list, the share of 0 disables the resource
+func (shares ResourceShares) Set(value string) (err error) {
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item == "" {
+			continue
+		}
+		name, shareStr, found := strings.Cut(item, "=")
+		if !found || name == "" {
+			return fmt.Errorf("invalid resource share %q, expected name=share", item)
+		}
+		var share float64
+		if share, err = strconv.ParseFloat(shareStr, 64); err != nil || share < 0 || share > 1 {
+			return fmt.Errorf("invalid resource share %q, expected a number from 0 to 1", item)
+		}
+		shares[name] = share
+	}
+	return
+}
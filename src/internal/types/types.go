@@ -31,6 +31,10 @@ const (
 	CostRefreshInterval = time.Hour
 	// Node hourly cost
 	AnnotationNodeHourlyCost = annotationDomain + "/node-hourly-cost"
+	// Part of the node hourly cost for its disks, ephemeral storage is priced from
+	AnnotationNodeStorageHourlyCost = annotationDomain + "/node-storage-hourly-cost"
+	// Persistent volume hourly cost
+	AnnotationVolumeHourlyCost = annotationDomain + "/volume-hourly-cost"
 	// Stores timestamp of the last cost update
 	AnnotationCostUpdatedAt = annotationDomain + "/cost-updated-at"
 	// Spot or on-demand
@@ -39,6 +43,20 @@ const (
 	AnnotationNodeType = annotationDomain + "/type"
 	// Node location
 	AnnotationNodeAvailabilityZone = annotationDomain + "/availability-zone"
+	// Currency of the node hourly cost
+	AnnotationNodeCurrency = annotationDomain + "/currency"
+	// Operating system license of the node
+	AnnotationNodeLicense = annotationDomain + "/license"
+	// Ratio of the invoiced to the list cost from the Cost and Usage Report
+	AnnotationNodeCorrectionFactor = annotationDomain + "/correction-factor"
+	// Shares of the node cost per extended resource: nvidia.com/gpu=0.8
+	AnnotationNodeExtendedResourceShares = annotationDomain + "/extended-resource-shares"
+	// GiBs of memory 1 CPU core of the node costs, overrides the split strategy
+	AnnotationNodeCPUMemoryCostRatio = annotationDomain + "/cpu-memory-cost-ratio"
+	// Cost of the terminated pod for its runtime, summed up for all pods on the owning Job
+	AnnotationFinalCost = annotationDomain + "/final-cost"
+	// Currency used if nothing else is known
+	DefaultCurrency = "USD"
 	// Placeholder for an unknown price
 	UnknownCost = "unknown"
 )
@@ -77,6 +95,25 @@ type NodeInfo struct {
 	Capacity string
 	// Availability zone
 	AvailabilityZone string
+	// Currency of the hourly cost: USD, CNY, etc.
+	Currency string
+	// Operating system and pre-installed software license: Linux/UNIX, Windows with SQL Server Standard, etc.
+	License string
+	// Instance tenancy: default, dedicated or host
+	Tenancy string
+}
+
+// PVInfo contains information about the persistent volume consumer.
+type PVInfo struct {
+	// Bound claim namespace
+	Namespace string
+	// Bound claim name
+	Claim string
+	// Owner of the pod mounting the claim
+	Owner struct {
+		Kind string
+		Name string
+	}
 }
 
 // PodInfo contains provider information about the pod.
@@ -89,5 +126,17 @@ type PodInfo struct {
 	NodeHourlyCost          float64
 	NodeCPUCoreHourlyCost   float64
 	NodeMemoryMiBHourlyCost float64
-	PodRequestsHourlyCost   float64
+	// Strategy of the node cost split between CPU and memory
+	SplitStrategy string
+	// Policy used for the missing requests, "requests" if none were missing
+	RequestsPolicy string
+	// Hourly cost of one unit per extended resource: nvidia.com/gpu, etc.
+	NodeExtendedResourceHourlyCosts map[string]float64
+	PodRequestsHourlyCost           float64
+	// Part of the requests cost per requested resource: cpu, memory, nvidia.com/gpu, etc.
+	PodResourcesHourlyCosts map[string]float64
+	// Requests cost per container, empty if the container metrics are disabled
+	ContainersRequestsHourlyCosts map[string]float64
+	// Requests cost of the unscheduled pod on the nodes it fits
+	PodPendingEstimatedHourlyCost float64
 }
@@ -0,0 +1,29 @@
+// Copyright 2025 The MoneyPod Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package usage
+
+import (
+	"github.com/vlasov-y/moneypod/internal/cluster"
+)
+
+// getEffectiveHourlyCost charges the bigger of the usage and the requests per CPU and memory,
+// extended resources are charged by the requests
+func getEffectiveHourlyCost(cost cluster.PodCost, cpuUsageHourlyCost float64,
+	memoryUsageHourlyCost float64) (hourlyCost float64) {
+	hourlyCost = cost.RequestsHourlyCost - cost.CPURequestsHourlyCost - cost.MemoryRequestsHourlyCost
+	hourlyCost += max(cpuUsageHourlyCost, cost.CPURequestsHourlyCost)
+	hourlyCost += max(memoryUsageHourlyCost, cost.MemoryRequestsHourlyCost)
+	return
+}
@@ -0,0 +1,34 @@
+// Copyright 2025 The MoneyPod Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package usage
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/vlasov-y/moneypod/internal/cluster"
+)
+
+var _ = Describe("getEffectiveHourlyCost", func() {
+	cost := cluster.PodCost{RequestsHourlyCost: 10, CPURequestsHourlyCost: 2, MemoryRequestsHourlyCost: 3}
+
+	It("should charge the bigger of the usage and the requests per resource", func() {
+		// CPU usage over the request, memory usage under it, GPU requests as is
+		Expect(getEffectiveHourlyCost(cost, 4, 1)).To(BeNumerically("~", 5+4+3, 1e-9))
+	})
+
+	It("should charge the requests without usage", func() {
+		Expect(getEffectiveHourlyCost(cost, 0, 0)).To(BeNumerically("~", 10, 1e-9))
+	})
+})
@@ -0,0 +1,39 @@
+// Copyright 2025 The MoneyPod Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package usage
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metricsv1beta1 "k8s.io/metrics/pkg/apis/metrics/v1beta1"
+)
+
+// getUsageHourlyCost prices the CPU and memory used by the containers
+func getUsageHourlyCost(containers []metricsv1beta1.ContainerMetrics,
+	cpuCoreHourlyCost float64, memoryMiBHourlyCost float64) (cpuHourlyCost float64, memoryHourlyCost float64) {
+	// Define base resource units
+	cpuCore := resource.MustParse("1.0")
+	memoryMiB := resource.MustParse("1Mi")
+	cpuCoreFloat := cpuCore.AsApproximateFloat64()
+	memoryMiBFloat := memoryMiB.AsApproximateFloat64()
+
+	for _, container := range containers {
+		cpu := container.Usage[corev1.ResourceCPU]
+		memory := container.Usage[corev1.ResourceMemory]
+		cpuHourlyCost += cpu.AsApproximateFloat64() / cpuCoreFloat * cpuCoreHourlyCost
+		memoryHourlyCost += memory.AsApproximateFloat64() / memoryMiBFloat * memoryMiBHourlyCost
+	}
+	return
+}
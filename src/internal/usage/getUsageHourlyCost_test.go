@@ -0,0 +1,46 @@
+// Copyright 2025 The MoneyPod Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package usage
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metricsv1beta1 "k8s.io/metrics/pkg/apis/metrics/v1beta1"
+)
+
+var _ = Describe("getUsageHourlyCost", func() {
+	It("should price the usage of all containers", func() {
+		containers := []metricsv1beta1.ContainerMetrics{
+			{Usage: corev1.ResourceList{
+				corev1.ResourceCPU:    resource.MustParse("500m"),
+				corev1.ResourceMemory: resource.MustParse("256Mi"),
+			}},
+			{Usage: corev1.ResourceList{
+				corev1.ResourceCPU:    resource.MustParse("1500m"),
+				corev1.ResourceMemory: resource.MustParse("768Mi"),
+			}},
+		}
+		cpuHourlyCost, memoryHourlyCost := getUsageHourlyCost(containers, 0.02, 0.0001)
+		Expect(cpuHourlyCost).To(BeNumerically("~", 2*0.02, 1e-9))
+		Expect(memoryHourlyCost).To(BeNumerically("~", 1024*0.0001, 1e-9))
+	})
+
+	It("should cost nothing without containers", func() {
+		cpuHourlyCost, memoryHourlyCost := getUsageHourlyCost(nil, 0.02, 0.0001)
+		Expect(cpuHourlyCost + memoryHourlyCost).To(BeZero())
+	})
+})
@@ -0,0 +1,82 @@
+// Copyright 2025 The MoneyPod Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package usage samples the pod resources usage and prices it with the node reference costs.
+package usage
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+// Options configures the usage sampler.
+type Options struct {
+	// How often the pod metrics are read, the sampler is disabled if zero
+	Interval time.Duration
+	// Export the usage cost per container
+	ContainerMetrics bool
+}
+
+// Job periodically reads the pod metrics and exports the usage cost.
+type Job struct {
+	// Reader of the metrics API, which cannot be watched and cached
+	client.Reader
+	opts Options
+	// Timestamps of the last samples the usage cost is integrated from,
+	// the run time for the pods without a sample charged by the requests
+	samples map[types.NamespacedName]time.Time
+}
+
+// NewJob creates the sampler reading the metrics with the given reader.
+func NewJob(ctx context.Context, reader client.Reader, opts Options) (job *Job, err error) {
+	log := logf.FromContext(ctx)
+
+	if opts.Interval <= 0 {
+		err = errors.New("usage interval must be positive")
+		log.Error(err, "invalid usage options")
+		return
+	}
+
+	job = &Job{Reader: reader, opts: opts, samples: map[types.NamespacedName]time.Time{}}
+	return
+}
+
+// Start samples the usage right away and then every interval until the context is cancelled.
+func (job *Job) Start(ctx context.Context) error {
+	log := logf.FromContext(ctx).WithName("usage")
+	ctx = logf.IntoContext(ctx, log)
+
+	ticker := time.NewTicker(job.opts.Interval)
+	defer ticker.Stop()
+	for {
+		if err := job.run(ctx); err != nil {
+			log.Error(err, "failed to sample the pods usage")
+		}
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// NeedLeaderElection runs the job along with the controllers filling the pod costs.
+func (job *Job) NeedLeaderElection() bool {
+	return true
+}
@@ -0,0 +1,117 @@
+// Copyright 2025 The MoneyPod Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package usage
+
+import (
+	"context"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/vlasov-y/moneypod/internal/cluster"
+	"github.com/vlasov-y/moneypod/internal/monitoring"
+	"k8s.io/apimachinery/pkg/types"
+	metricsv1beta1 "k8s.io/metrics/pkg/apis/metrics/v1beta1"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+// run prices the latest usage and the effective cost of the known pods and integrates them since the previous sample.
+// Pods without a sample are charged by the requests, so the effective cost counter covers all of them.
+func (job *Job) run(ctx context.Context) (err error) {
+	log := logf.FromContext(ctx)
+	now := time.Now()
+
+	podMetricsList := metricsv1beta1.PodMetricsList{}
+	if err = job.List(ctx, &podMetricsList); err != nil {
+		log.Error(err, "failed to list pod metrics")
+		return
+	}
+
+	sampled := map[types.NamespacedName]bool{}
+	for i := range podMetricsList.Items {
+		podMetrics := &podMetricsList.Items[i]
+		key := types.NamespacedName{Namespace: podMetrics.Namespace, Name: podMetrics.Name}
+		// Pod is not priced yet
+		cost, found := cluster.GetPodCost(key)
+		if !found {
+			continue
+		}
+		sampled[key] = true
+
+		cpuHourlyCost, memoryHourlyCost := getUsageHourlyCost(podMetrics.Containers, cost.CPUCoreHourlyCost,
+			cost.MemoryMiBHourlyCost)
+		usageHourlyCost := cpuHourlyCost + memoryHourlyCost
+		cluster.SetPodUsageHourlyCost(key, usageHourlyCost)
+		effectiveHourlyCost := getEffectiveHourlyCost(cost, cpuHourlyCost, memoryHourlyCost)
+		labels := []string{key.Name, key.Name, key.Namespace, cost.OwnerKind, cost.OwnerName, cost.Node}
+		monitoring.PodUsageHourlyCostMetric.WithLabelValues(labels...).Set(usageHourlyCost)
+		monitoring.PodEffectiveHourlyCostMetric.WithLabelValues(labels...).Set(effectiveHourlyCost)
+		if job.opts.ContainerMetrics {
+			for i, container := range podMetrics.Containers {
+				cpuHourlyCost, memoryHourlyCost := getUsageHourlyCost(podMetrics.Containers[i:i+1],
+					cost.CPUCoreHourlyCost, cost.MemoryMiBHourlyCost)
+				monitoring.ContainerUsageHourlyCostMetric.WithLabelValues(append(labels, container.Name)...).
+					Set(cpuHourlyCost + memoryHourlyCost)
+			}
+		}
+
+		// Usage is an average over the window ending at the timestamp, so it is charged since the previous one
+		timestamp := podMetrics.Timestamp.Time
+		previous, exists := job.samples[key]
+		if exists && !timestamp.After(previous) {
+			continue
+		}
+		var hours float64
+		if exists {
+			hours = timestamp.Sub(previous).Hours()
+		}
+		monitoring.PodUsageCostMetric.WithLabelValues(labels...).Add(usageHourlyCost * hours)
+		monitoring.PodEffectiveCostMetric.WithLabelValues(labels...).Add(effectiveHourlyCost * hours)
+		job.samples[key] = timestamp
+	}
+
+	// Effective cost is at least the requests cost, which is charged until the pod is sampled
+	for key, cost := range cluster.ListPodCosts() {
+		if sampled[key] {
+			continue
+		}
+		previous, exists := job.samples[key]
+		if exists && !now.After(previous) {
+			continue
+		}
+		var hours float64
+		if exists {
+			hours = now.Sub(previous).Hours()
+		}
+		labels := []string{key.Name, key.Name, key.Namespace, cost.OwnerKind, cost.OwnerName, cost.Node}
+		monitoring.PodEffectiveCostMetric.WithLabelValues(labels...).Add(cost.RequestsHourlyCost * hours)
+		job.samples[key] = now
+	}
+
+	// Forget the deleted pods
+	for key := range job.samples {
+		if _, found := cluster.GetPodCost(key); sampled[key] || found {
+			continue
+		}
+		delete(job.samples, key)
+		labels := prometheus.Labels{"name": key.Name, "namespace": key.Namespace}
+		monitoring.PodUsageHourlyCostMetric.DeletePartialMatch(labels)
+		monitoring.PodUsageCostMetric.DeletePartialMatch(labels)
+		monitoring.PodEffectiveHourlyCostMetric.DeletePartialMatch(labels)
+		monitoring.PodEffectiveCostMetric.DeletePartialMatch(labels)
+		monitoring.ContainerUsageHourlyCostMetric.DeletePartialMatch(labels)
+	}
+	log.V(1).Info("sampled the pods usage", "pods", len(sampled))
+	return
+}
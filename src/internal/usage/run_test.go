@@ -0,0 +1,123 @@
+// Copyright 2025 The MoneyPod Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package usage
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/vlasov-y/moneypod/internal/cluster"
+	"github.com/vlasov-y/moneypod/internal/monitoring"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	metricsv1beta1 "k8s.io/metrics/pkg/apis/metrics/v1beta1"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+var _ = Describe("run", func() {
+	key := types.NamespacedName{Namespace: "default", Name: "app"}
+	labels := []string{"app", "app", "default", "Deployment", "app", "node-1"}
+
+	podMetrics := func(timestamp time.Time) *metricsv1beta1.PodMetrics {
+		return &metricsv1beta1.PodMetrics{
+			ObjectMeta: metav1.ObjectMeta{Namespace: key.Namespace, Name: key.Name},
+			Timestamp:  metav1.NewTime(timestamp),
+			Containers: []metricsv1beta1.ContainerMetrics{{Name: "app", Usage: corev1.ResourceList{
+				corev1.ResourceCPU: resource.MustParse("2"),
+			}}},
+		}
+	}
+
+	AfterEach(func() {
+		cluster.DeletePodCost(key)
+		monitoring.PodUsageHourlyCostMetric.Reset()
+		monitoring.PodUsageCostMetric.Reset()
+		monitoring.PodEffectiveHourlyCostMetric.Reset()
+		monitoring.PodEffectiveCostMetric.Reset()
+		monitoring.ContainerUsageHourlyCostMetric.Reset()
+	})
+
+	It("should integrate the usage cost between the samples and forget deleted pods", func() {
+		scheme := runtime.NewScheme()
+		Expect(metricsv1beta1.AddToScheme(scheme)).To(Succeed())
+		start := time.Now().Truncate(time.Second)
+		reader := fake.NewClientBuilder().WithScheme(scheme).WithObjects(podMetrics(start)).Build()
+		job, err := NewJob(ctx, reader, Options{Interval: time.Minute, ContainerMetrics: true})
+		Expect(err).NotTo(HaveOccurred())
+
+		cluster.SetPodCost(key, cluster.PodCost{
+			Namespace: key.Namespace, Node: "node-1", OwnerKind: "Deployment", OwnerName: "app", CPUCoreHourlyCost: 0.5,
+			RequestsHourlyCost: 1.5, CPURequestsHourlyCost: 0.5, MemoryRequestsHourlyCost: 1,
+		})
+		Expect(job.run(ctx)).To(Succeed())
+		Expect(testutil.ToFloat64(monitoring.PodUsageHourlyCostMetric.WithLabelValues(labels...))).To(Equal(1.0))
+		// Counters appear with the first sample
+		Expect(testutil.ToFloat64(monitoring.PodUsageCostMetric.WithLabelValues(labels...))).To(BeZero())
+		Expect(testutil.ToFloat64(monitoring.PodEffectiveCostMetric.WithLabelValues(labels...))).To(BeZero())
+		Expect(testutil.ToFloat64(monitoring.ContainerUsageHourlyCostMetric.WithLabelValues(append(labels, "app")...))).
+			To(Equal(1.0))
+		// CPU usage over the request, memory usage under it
+		Expect(testutil.ToFloat64(monitoring.PodEffectiveHourlyCostMetric.WithLabelValues(labels...))).To(Equal(2.0))
+
+		// Half an hour later at 1 per hour
+		Expect(reader.Delete(ctx, podMetrics(start))).To(Succeed())
+		Expect(reader.Create(ctx, podMetrics(start.Add(30*time.Minute)))).To(Succeed())
+		Expect(job.run(ctx)).To(Succeed())
+		Expect(testutil.ToFloat64(monitoring.PodUsageCostMetric.WithLabelValues(labels...))).To(
+			BeNumerically("~", 0.5, 1e-9))
+
+		Expect(testutil.ToFloat64(monitoring.PodEffectiveCostMetric.WithLabelValues(labels...))).To(
+			BeNumerically("~", 1, 1e-9))
+
+		// Same sample is not charged twice
+		Expect(job.run(ctx)).To(Succeed())
+		Expect(testutil.ToFloat64(monitoring.PodUsageCostMetric.WithLabelValues(labels...))).To(
+			BeNumerically("~", 0.5, 1e-9))
+
+		cluster.DeletePodCost(key)
+		Expect(reader.Delete(ctx, podMetrics(start))).To(Succeed())
+		Expect(job.run(ctx)).To(Succeed())
+		Expect(job.samples).To(BeEmpty())
+		Expect(testutil.CollectAndCount(monitoring.PodUsageHourlyCostMetric)).To(BeZero())
+		Expect(testutil.CollectAndCount(monitoring.PodEffectiveCostMetric)).To(BeZero())
+		Expect(testutil.CollectAndCount(monitoring.ContainerUsageHourlyCostMetric)).To(BeZero())
+	})
+
+	It("should charge the requests as the effective cost of the pods without samples", func() {
+		scheme := runtime.NewScheme()
+		Expect(metricsv1beta1.AddToScheme(scheme)).To(Succeed())
+		reader := fake.NewClientBuilder().WithScheme(scheme).Build()
+		job, err := NewJob(ctx, reader, Options{Interval: time.Minute})
+		Expect(err).NotTo(HaveOccurred())
+
+		cluster.SetPodCost(key, cluster.PodCost{
+			Namespace: key.Namespace, Node: "node-1", OwnerKind: "Deployment", OwnerName: "app", RequestsHourlyCost: 1.5,
+		})
+		Expect(job.run(ctx)).To(Succeed())
+		Expect(testutil.ToFloat64(monitoring.PodEffectiveCostMetric.WithLabelValues(labels...))).To(BeZero())
+
+		// Charged since the previous run
+		job.samples[key] = job.samples[key].Add(-time.Hour)
+		Expect(job.run(ctx)).To(Succeed())
+		Expect(testutil.ToFloat64(monitoring.PodEffectiveCostMetric.WithLabelValues(labels...))).To(
+			BeNumerically("~", 1.5, 1e-3))
+		Expect(testutil.CollectAndCount(monitoring.PodUsageCostMetric)).To(BeZero())
+	})
+})